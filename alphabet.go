@@ -1,9 +1,11 @@
 package gibberdet
 
 import (
-	"bufio"
 	"bytes"
+	"fmt"
 	"io"
+	"sort"
+	"unicode/utf8"
 )
 
 const (
@@ -21,6 +23,10 @@ var (
 	ASCIIAlnum          = NewAlphabet([]rune(alpha + numeric + wsp))
 )
 
+// OtherRune stands for the catch-all OTHER symbol in an Alphabet's Runes().
+// See WithOther.
+const OtherRune rune = -1
+
 type Alphabet interface {
 	Runes() []rune
 	Len() int
@@ -29,26 +35,58 @@ type Alphabet interface {
 }
 
 func MergeAlphabet(a1, a2 Alphabet) Alphabet {
-	a1r, a2r := a1.Runes(), a2.Runes()
-	r := make([]rune, len(a1r)+len(a2r))
-	p := copy(r, a1r)
-	copy(r[p:], a2r)
-	return NewAlphabet(r)
+	return AlphabetUnion(a1, a2)
 }
 
-type alphaNode struct {
-	next [256]*alphaNode
-	set  bool
-	pos  int
-	sz   int
+// WithOther returns a copy of a with a catch-all OTHER symbol added to the
+// end. The Trainer and the Model treat every rune that isn't in a as OTHER,
+// instead of skipping it or scoring it with a fixed penalty, so the model
+// learns how often unknown runes, like punctuation or accented letters,
+// appear next to the runes in a.
+//
+// OTHER appears in Runes() as OtherRune, and FindRune(OtherRune) returns its
+// position. FindRune and FindByte still return -1 for runes that are not in
+// the alphabet.
+func WithOther(a Alphabet) Alphabet {
+	if a.FindRune(OtherRune) >= 0 {
+		return a
+	}
+	if ca, ok := a.(classAlphabet); ok && ca.Classes() != nil {
+		classes := append([][]rune(nil), ca.Classes()...)
+		return NewClassAlphabet(append(classes, []rune{OtherRune})...)
+	}
+	runes := append([]rune(nil), a.Runes()...)
+	return NewAlphabet(append(runes, OtherRune))
 }
 
+// runeAlphabet looks runes in the Basic Multilingual Plane up in a two-level
+// page table: the high byte of the rune selects a page of 256 entries, which
+// is only allocated if the alphabet contains a rune in that page. Runes
+// outside the BMP are rare enough in alphabets that a binary search over a
+// sorted slice is fine.
 type runeAlphabet struct {
-	root  *alphaNode
-	runes []rune
-	ln    int
-	max   rune
-	enc   [4]byte
+	pages  [runePageCount]*runePage
+	astral []runePos // Runes > 0xFFFF, sorted by rune
+	runes  []rune
+	max    rune
+
+	// Members of each class, indexed by position. This is nil unless at
+	// least one class has more than one rune. See NewClassAlphabet.
+	classes [][]rune
+
+	// Position of OtherRune plus one, like the page entries.
+	other int32
+}
+
+const runePageCount = 256
+
+// Entries are the rune's position in the alphabet plus one, so the zero value
+// means "not found".
+type runePage [256]int32
+
+type runePos struct {
+	rn  rune
+	pos int32
 }
 
 func NewAlphabet(runes []rune) Alphabet {
@@ -59,35 +97,24 @@ func NewAlphabet(runes []rune) Alphabet {
 	return ra
 }
 
+// AlphabetFromReader builds an Alphabet from every valid rune found in rdr,
+// in order of first appearance. If scratch is nil, a buffer is allocated.
+//
+// Use an AlphabetBuilder to exclude rare runes.
 func AlphabetFromReader(rdr io.Reader, scratch []byte) (Alphabet, error) {
-	if scratch == nil {
-		scratch = make([]byte, 8192)
+	ab := NewAlphabetBuilder()
+	if scratch != nil {
+		ab.scratch = scratch
 	}
-
-	buf := bufio.NewReader(rdr)
-
-	a := newRuneAlphabet(nil)
-	for {
-		r, _, err := buf.ReadRune()
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return nil, err
-		}
-		a.add(r)
+	if err := ab.Add(rdr); err != nil {
+		return nil, err
 	}
-
-	if a.max < 128 {
-		return a.asASCII(), nil
-	}
-
+	a, _ := ab.Build()
 	return a, nil
 }
 
 func newRuneAlphabet(runes []rune) *runeAlphabet {
-	node := &alphaNode{}
 	al := &runeAlphabet{
-		root:  node,
 		runes: make([]rune, 0, len(runes)),
 	}
 	for _, rn := range runes {
@@ -101,7 +128,7 @@ func (al *runeAlphabet) Runes() []rune {
 }
 
 func (al *runeAlphabet) Len() int {
-	return al.ln
+	return len(al.runes)
 }
 
 func (al *runeAlphabet) FindByte(b byte) (pos int) {
@@ -109,71 +136,123 @@ func (al *runeAlphabet) FindByte(b byte) (pos int) {
 }
 
 func (al *runeAlphabet) FindRune(rn rune) (pos int) {
-	cur := al.root
-	n := 0
-	for rv := rn; rv > 0; rv >>= 8 {
-		b := uint8(rv)
-		if cur.next[b] == nil {
-			break
+	if uint32(rn) <= 0xFFFF {
+		page := al.pages[rn>>8]
+		if page == nil {
+			return -1
 		}
-		cur = cur.next[b]
-		n++
+		return int(page[rn&0xFF]) - 1
 	}
-	if cur == nil || !cur.set || cur.sz != n {
-		return -1
+
+	if rn == OtherRune {
+		return int(al.other) - 1
+	}
+
+	astral := al.astral
+	lo, hi := 0, len(astral)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if astral[mid].rn < rn {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(astral) && astral[lo].rn == rn {
+		return int(astral[lo].pos)
 	}
-	return cur.pos
+	return -1
 }
 
 func (al *runeAlphabet) add(rn rune) {
-	cur := al.root
-	n := 0
-	for rv := rn; rv > 0; rv >>= 8 {
-		b := uint8(rv)
-		if cur.next[b] == nil {
-			cur.next[b] = &alphaNode{}
+	if (rn < 0 && rn != OtherRune) || al.FindRune(rn) >= 0 {
+		return
+	}
+
+	pos := len(al.runes)
+	al.set(rn, pos)
+	al.runes = append(al.runes, rn)
+	if al.classes != nil {
+		al.classes = append(al.classes, []rune{rn})
+	}
+}
+
+// addClass adds a class containing each of the members that are not already
+// in the alphabet. The first of these is the class's representative in
+// Runes().
+func (al *runeAlphabet) addClass(members []rune) {
+	var added []rune
+	pos := len(al.runes)
+	for _, rn := range members {
+		if (rn < 0 && rn != OtherRune) || al.FindRune(rn) >= 0 {
+			continue
 		}
-		cur = cur.next[b]
-		n++
-	}
-	if !cur.set {
-		al.runes = append(al.runes, rn)
-		cur.set = true
-		cur.pos = al.ln
-		cur.sz = n
-		al.ln++
-		if rn > al.max {
-			al.max = rn
+		al.set(rn, pos)
+		added = append(added, rn)
+	}
+	if len(added) == 0 {
+		return
+	}
+
+	if len(added) > 1 && al.classes == nil {
+		al.classes = make([][]rune, len(al.runes), len(al.runes)+1)
+		for i, rn := range al.runes {
+			al.classes[i] = []rune{rn}
 		}
 	}
+	al.runes = append(al.runes, added[0])
+	if al.classes != nil {
+		al.classes = append(al.classes, added)
+	}
 }
 
-func (al *runeAlphabet) asASCII() *asciiAlphabet {
-	return newASCIIAlphabet(al.runes)
-}
+func (al *runeAlphabet) set(rn rune, pos int) {
+	if rn == OtherRune {
+		al.other = int32(pos + 1)
+	} else if rn <= 0xFFFF {
+		page := al.pages[rn>>8]
+		if page == nil {
+			page = &runePage{}
+			al.pages[rn>>8] = page
+		}
+		page[rn&0xFF] = int32(pos + 1)
+	} else {
+		idx := sort.Search(len(al.astral), func(i int) bool { return al.astral[i].rn > rn })
+		al.astral = append(al.astral, runePos{})
+		copy(al.astral[idx+1:], al.astral[idx:])
+		al.astral[idx] = runePos{rn: rn, pos: int32(pos)}
+	}
 
-type asciiAlphabet struct {
-	pos   [256]int // 256 instead of 128 to avoid bounds check
-	runes []rune
+	if rn > al.max {
+		al.max = rn
+	}
 }
 
-func newASCIIAlphabet(runes []rune) *asciiAlphabet {
-	al := &asciiAlphabet{
-		runes: runes,
+func (al *runeAlphabet) asASCII() *asciiAlphabet {
+	if al.max > 127 {
+		panic("expected ASCII")
+	}
+	asc := &asciiAlphabet{
+		runes:   al.runes,
+		classes: al.classes,
+		other:   int(al.other) - 1,
 	}
-	_ = al.pos[255]
+	_ = asc.pos[255]
 	for i := 0; i < 256; i++ {
-		al.pos[i] = -1
+		asc.pos[i] = al.FindRune(rune(i))
 	}
+	return asc
+}
 
-	for idx, rn := range runes {
-		if rn > 127 {
-			panic("expected ASCII")
-		}
-		b := byte(rn)
-		al.pos[b] = idx
-	}
-	return al
+type asciiAlphabet struct {
+	pos     [256]int // 256 instead of 128 to avoid bounds check
+	runes   []rune
+	classes [][]rune
+	other   int
+}
+
+func newASCIIAlphabet(runes []rune) *asciiAlphabet {
+	return newRuneAlphabet(runes).asASCII()
 }
 
 func (al *asciiAlphabet) Runes() []rune {
@@ -189,17 +268,105 @@ func (al *asciiAlphabet) FindByte(b byte) (pos int) {
 }
 
 func (al *asciiAlphabet) FindRune(rn rune) (pos int) {
-	if rn > 127 {
-		return 0
+	if rn < 0 || rn > 127 {
+		if rn == OtherRune {
+			return al.other
+		}
+		return -1
 	}
 	return al.pos[byte(rn)]
 }
 
+// classAlphabet is implemented by Alphabets that may map more than one rune
+// to the same position.
+type classAlphabet interface {
+	Alphabet
+
+	// Classes returns the runes at each position, or nil if every position
+	// has a single rune.
+	Classes() [][]rune
+}
+
+func (al *runeAlphabet) Classes() [][]rune  { return al.classes }
+func (al *asciiAlphabet) Classes() [][]rune { return al.classes }
+
+// Alphabets with classes are encoded as a 0xFF byte followed by the members of
+// each class, separated by 0xFF. 0xFF never appears in UTF-8, so this can't be
+// confused with the original encoding, which is just the runes. Likewise,
+// OtherRune is encoded as a single 0xFE byte, and ByteAlphabet as a single
+// 0xFD byte.
+const (
+	alphabetClassSep = 0xFF
+	alphabetOther    = 0xFE
+	alphabetBytes    = 0xFD
+)
+
 func marshalAlphabet(a Alphabet) (data []byte, err error) {
-	return []byte(string(a.Runes())), nil
+	if _, ok := a.(*byteAlphabet); ok {
+		return []byte{alphabetBytes}, nil
+	}
+	if ca, ok := a.(classAlphabet); ok && ca.Classes() != nil {
+		for _, class := range ca.Classes() {
+			data = append(data, alphabetClassSep)
+			data = appendAlphabetRunes(data, class)
+		}
+		return data, nil
+	}
+	return appendAlphabetRunes(nil, a.Runes()), nil
+}
+
+func appendAlphabetRunes(data []byte, runes []rune) []byte {
+	var enc [utf8.UTFMax]byte
+	for _, rn := range runes {
+		if rn == OtherRune {
+			data = append(data, alphabetOther)
+		} else {
+			n := utf8.EncodeRune(enc[:], rn)
+			data = append(data, enc[:n]...)
+		}
+	}
+	return data
 }
 
 func unmarshalAlphabet(data []byte, into *Alphabet) (err error) {
-	*into = NewAlphabet(bytes.Runes(data))
+	if len(data) == 1 && data[0] == alphabetBytes {
+		*into = ByteAlphabet
+		return nil
+	}
+	if len(data) > 0 && data[0] == alphabetClassSep {
+		parts := bytes.Split(data[1:], []byte{alphabetClassSep})
+		classes := make([][]rune, len(parts))
+		for i, part := range parts {
+			if classes[i], err = decodeAlphabetRunes(part); err != nil {
+				return err
+			}
+		}
+		*into = NewClassAlphabet(classes...)
+		return nil
+	}
+
+	runes, err := decodeAlphabetRunes(data)
+	if err != nil {
+		return err
+	}
+	*into = NewAlphabet(runes)
 	return nil
 }
+
+func decodeAlphabetRunes(data []byte) ([]rune, error) {
+	runes := make([]rune, 0, len(data))
+	for len(data) > 0 {
+		if data[0] == alphabetOther {
+			runes = append(runes, OtherRune)
+			data = data[1:]
+			continue
+		}
+		rn, sz := utf8.DecodeRune(data)
+		if rn == utf8.RuneError && sz <= 1 {
+			return nil, fmt.Errorf("gibberdet: invalid UTF-8 in alphabet")
+		}
+		runes = append(runes, rn)
+		data = data[sz:]
+	}
+	return runes, nil
+}
@@ -1,8 +1,10 @@
 package gibberdet
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+	"unicode"
 )
 
 var BenchIntResult int
@@ -52,6 +54,265 @@ func TestAlphabetRuneFromReader(t *testing.T) {
 	}
 }
 
+func TestAlphabetASCIIFindRuneNonASCII(t *testing.T) {
+	// Runes above 127 used to be found at position 0, so the Trainer counted
+	// them as the alphabet's first rune.
+	for _, rn := range []rune{'é', 'ā', '中', 0x10061} {
+		if p := ASCIIAlpha.FindRune(rn); p != -1 {
+			t.Fatalf("%q at %d", rn, p)
+		}
+	}
+
+	tr := NewTrainer(NewAlphabet([]rune("ab")), TrainerPairWeight(0))
+	if err := tr.Add(strings.NewReader("éaéb")); err != nil {
+		t.Fatal(err)
+	}
+	if tr.gram[0] != 0 || tr.gram[1] != 0 {
+		t.Fatal(tr.gram)
+	}
+}
+
+func TestAlphabetBuilder(t *testing.T) {
+	ab := NewAlphabetBuilder()
+	if err := ab.Add(strings.NewReader("aaaaaaaa bbbb cc d\x00 \xff🙃")); err != nil {
+		t.Fatal(err)
+	}
+
+	for idx, tc := range []struct {
+		opts []AlphabetBuildOption
+		out  string
+	}{
+		{nil, "a bcd\x00🙃"},
+		{[]AlphabetBuildOption{AlphabetMinCount(2)}, "a bc"},
+		{[]AlphabetBuildOption{AlphabetTopN(3)}, "a b"},
+		{[]AlphabetBuildOption{AlphabetCoverage(0.6)}, "a b"},
+		{[]AlphabetBuildOption{AlphabetIncludeCategories(unicode.Letter)}, "abcd"},
+		{[]AlphabetBuildOption{AlphabetExcludeCategories(unicode.Cc, unicode.So)}, "a bcd"},
+	} {
+		a, report := ab.Build(tc.opts...)
+		if string(a.Runes()) != tc.out {
+			t.Fatalf("%d: %q != %q", idx, string(a.Runes()), tc.out)
+		}
+		if len(report.Kept)+len(report.Discarded) != 7 || report.Total != 21 {
+			t.Fatal(idx, report)
+		}
+	}
+}
+
+func TestParseAlphabetSpec(t *testing.T) {
+	for idx, tc := range []struct {
+		spec string
+		out  string
+	}{
+		{`a-cX-Z0-2_\- '`, "abcXYZ012_- '"},
+		{`-ab`, "-ab"},
+		{`ab-`, "ab-"},
+		{`\u0041\U0001F643\t\\`, "A🙃\t\\"},
+		{`天-夫`, "天太夫"},
+	} {
+		a, err := ParseAlphabetSpec(tc.spec)
+		if err != nil {
+			t.Fatal(idx, err)
+		}
+		if runes := string(a.Runes()); runes != tc.out {
+			t.Fatalf("%d: %q != %q", idx, runes, tc.out)
+		}
+	}
+
+	{
+		a, err := ParseAlphabetSpec(`a\p{Nd}b`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		runes := a.Runes()
+		if string(runes[:11]) != "a0123456789" || a.FindRune('٣') < 0 || runes[len(runes)-1] != 'b' {
+			t.Fatal(string(runes))
+		}
+	}
+
+	for idx, spec := range []string{`z-a`, `\q`, `\p{Nope}`, `\u12`, `abc\`, `\p{L`} {
+		if _, err := ParseAlphabetSpec(spec); err == nil {
+			t.Fatal(idx, spec)
+		}
+	}
+}
+
+func TestAlphabetFromRangeTables(t *testing.T) {
+	a := AlphabetFromRangeTables(unicode.ASCII_Hex_Digit, unicode.Nd)
+	if a.FindRune('f') < 0 || a.FindRune('٣') < 0 || a.FindRune('g') >= 0 {
+		t.Fatal()
+	}
+
+	b, err := AlphabetFromCategories("ASCII_Hex_Digit", "Nd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(a.Runes()) != string(b.Runes()) {
+		t.Fatal()
+	}
+}
+
+func TestAlphabetSetOps(t *testing.T) {
+	abc := NewAlphabet([]rune("abc"))
+	bcd := NewAlphabet([]rune("dcb"))
+
+	for idx, tc := range []struct {
+		a   Alphabet
+		out string
+	}{
+		{AlphabetUnion(abc, bcd), "abcd"},
+		{AlphabetIntersect(bcd, abc), "cb"},
+		{AlphabetSubtract(abc, bcd), "a"},
+		{AlphabetSubtract(abc, abc), ""},
+	} {
+		if string(tc.a.Runes()) != tc.out {
+			t.Fatalf("%d: %q != %q", idx, string(tc.a.Runes()), tc.out)
+		}
+	}
+
+	if !AlphabetContains(abc, NewAlphabet([]rune("ca"))) || AlphabetContains(abc, bcd) {
+		t.Fatal()
+	}
+	if !AlphabetEqual(abc, NewAlphabet([]rune("cab"))) || AlphabetEqual(abc, bcd) {
+		t.Fatal()
+	}
+}
+
+func TestAlphabetSetOpsClasses(t *testing.T) {
+	merged := MergeAlphabet(ASCIIAlphaFolded, NewAlphabet([]rune("0")))
+	if merged.FindRune('A') != merged.FindRune('a') || merged.FindRune('A') < 0 || merged.Len() != ASCIIAlphaFolded.Len()+1 {
+		t.Fatal(AlphabetSpec(merged))
+	}
+	if AlphabetSpec(AlphabetUnion(ASCIIAlphaFolded)) != AlphabetSpec(ASCIIAlphaFolded) {
+		t.Fatal(AlphabetSpec(AlphabetUnion(ASCIIAlphaFolded)))
+	}
+
+	ab := NewClassAlphabet(FoldCase([]rune("ab"))...)
+	for idx, tc := range []struct {
+		a    Alphabet
+		spec string
+	}{
+		{AlphabetUnion(NewAlphabet([]rune("a")), ab), "aA[bB]"},
+		{AlphabetIntersect(ab, NewAlphabet([]rune("aAb"))), "[aA]b"},
+		{AlphabetSubtract(ab, NewAlphabet([]rune("A"))), "a[bB]"},
+	} {
+		if AlphabetSpec(tc.a) != tc.spec {
+			t.Fatalf("%d: %q != %q", idx, AlphabetSpec(tc.a), tc.spec)
+		}
+	}
+
+	if AlphabetContains(NewAlphabet([]rune("ab")), ab) || !AlphabetContains(ab, NewAlphabet([]rune("Ab"))) {
+		t.Fatal()
+	}
+}
+
+func TestAlphabetSpec(t *testing.T) {
+	for idx, tc := range []struct {
+		in   string
+		spec string
+	}{
+		{"abcdefXYZ012_- '", `a-fX-Z0-2_\- '`},
+		{"ab-\\\t\x00🙃\U000E0001", `ab\-\\\t\u0000🙃\U000E0001`},
+		{"天地玄黃", "天地玄黃"},
+		{"zyx", "zyx"},
+	} {
+		a := NewAlphabet([]rune(tc.in))
+		spec := AlphabetSpec(a)
+		if spec != tc.spec {
+			t.Fatalf("%d: %q != %q", idx, spec, tc.spec)
+		}
+		if fmt.Sprint(a) != spec {
+			t.Fatal(idx, a)
+		}
+		parsed, err := ParseAlphabetSpec(spec)
+		if err != nil {
+			t.Fatal(idx, err)
+		}
+		if string(parsed.Runes()) != tc.in {
+			t.Fatalf("%d: %q != %q", idx, string(parsed.Runes()), tc.in)
+		}
+	}
+}
+
+func TestClassAlphabet(t *testing.T) {
+	for idx, a := range []Alphabet{
+		NewClassAlphabet(FoldCase([]rune("ab"))...),
+		NewClassAlphabet(append(FoldCase([]rune("ab")), []rune("天地"))...),
+	} {
+		if string(a.Runes()[:2]) != "ab" {
+			t.Fatal(idx, string(a.Runes()))
+		}
+		for _, c := range []struct {
+			rn  rune
+			pos int
+		}{{'a', 0}, {'A', 0}, {'b', 1}, {'B', 1}, {'c', -1}} {
+			if p := a.FindRune(c.rn); p != c.pos {
+				t.Fatalf("%d: %q at %d, expected %d", idx, c.rn, p, c.pos)
+			}
+			if c.rn < 128 {
+				if p := a.FindByte(byte(c.rn)); p != c.pos {
+					t.Fatalf("%d: %q at %d, expected %d", idx, c.rn, p, c.pos)
+				}
+			}
+		}
+	}
+
+	a := NewClassAlphabet(append(FoldCase([]rune("ab")), DigitClass, []rune{' '}, []rune("[]"))...)
+	if a.Len() != 5 {
+		t.Fatal(a.Len())
+	}
+	spec := AlphabetSpec(a)
+	if spec != `[aA][bB][0-9] [\[\]]` {
+		t.Fatal(spec)
+	}
+	parsed, err := ParseAlphabetSpec(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if AlphabetSpec(parsed) != spec || parsed.FindRune('7') != 2 || parsed.FindRune(']') != 4 {
+		t.Fatal(parsed)
+	}
+
+	if _, err := ParseAlphabetSpec("[ab"); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestAlphabetWithOther(t *testing.T) {
+	for idx, base := range []Alphabet{
+		NewAlphabet([]rune("abc")),
+		NewAlphabet([]rune("abc天")),
+		NewClassAlphabet(FoldCase([]rune("abc"))...),
+	} {
+		a := WithOther(base)
+		if a.Len() != base.Len()+1 || a.FindRune(OtherRune) != base.Len() {
+			t.Fatal(idx, a.Len(), a.FindRune(OtherRune))
+		}
+		if a.FindRune('z') != -1 || a.FindRune('b') != 1 || base.FindRune(OtherRune) != -1 {
+			t.Fatal(idx)
+		}
+		if WithOther(a) != a {
+			t.Fatal(idx)
+		}
+
+		spec := AlphabetSpec(a)
+		if !strings.HasSuffix(spec, `\o`) {
+			t.Fatal(idx, spec)
+		}
+		parsed, err := ParseAlphabetSpec(spec)
+		if err != nil {
+			t.Fatal(idx, err)
+		}
+		if AlphabetSpec(parsed) != spec {
+			t.Fatal(idx, parsed)
+		}
+	}
+
+	if _, err := ParseAlphabetSpec(`\o-z`); err == nil {
+		t.Fatal()
+	}
+}
+
 func BenchmarkAlphabetFindRuneASCIIInterface(b *testing.B) {
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
@@ -81,3 +342,12 @@ func BenchmarkAlphabetFindRuneWide(b *testing.B) {
 		BenchIntResult = miscChineseAlpha.FindRune('道')
 	}
 }
+
+func BenchmarkAlphabetNewHan(b *testing.B) {
+	runes := appendRangeTable(nil, unicode.Han)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BenchIntResult = NewAlphabet(runes).Len()
+	}
+}
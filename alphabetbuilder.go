@@ -0,0 +1,196 @@
+package gibberdet
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// AlphabetBuilder counts the runes in a corpus so an Alphabet can be chosen
+// from the ones that are actually common, rather than every rune that was
+// ever seen (which is what AlphabetFromReader does). This keeps a stray emoji
+// or control character from growing the alphabet, and the model along with
+// it.
+type AlphabetBuilder struct {
+	counts  map[rune]*RuneCount
+	order   []*RuneCount
+	total   int64
+	scratch []byte
+}
+
+// RuneCount is the number of times a rune was seen by an AlphabetBuilder.
+type RuneCount struct {
+	Rune  rune
+	Count int64
+
+	// Position of the rune's first appearance in the input, used to keep the
+	// order of the runes in the built Alphabet stable.
+	first int
+}
+
+func NewAlphabetBuilder() *AlphabetBuilder {
+	return &AlphabetBuilder{
+		counts:  make(map[rune]*RuneCount),
+		scratch: make([]byte, defaultScratchSize),
+	}
+}
+
+// Add counts all of the valid runes in rdr. Invalid UTF-8 is ignored.
+func (ab *AlphabetBuilder) Add(rdr io.Reader) error {
+	return scanRunes(rdr, ab.scratch, func(r rune, sz int) {
+		if r == utf8.RuneError && sz == 1 {
+			return
+		}
+		ab.AddRune(r, 1)
+	})
+}
+
+// AddRune adds n to the count for r.
+func (ab *AlphabetBuilder) AddRune(r rune, n int64) {
+	rc := ab.counts[r]
+	if rc == nil {
+		rc = &RuneCount{Rune: r, first: len(ab.order)}
+		ab.counts[r] = rc
+		ab.order = append(ab.order, rc)
+	}
+	rc.Count += n
+	ab.total += n
+}
+
+type alphabetBuildConfig struct {
+	minCount int64
+	topN     int
+	coverage float64
+	include  []*unicode.RangeTable
+	exclude  []*unicode.RangeTable
+}
+
+type AlphabetBuildOption func(c *alphabetBuildConfig)
+
+// AlphabetMinCount discards runes seen fewer than n times.
+func AlphabetMinCount(n int64) AlphabetBuildOption {
+	return func(c *alphabetBuildConfig) { c.minCount = n }
+}
+
+// AlphabetTopN keeps at most the n most common runes.
+func AlphabetTopN(n int) AlphabetBuildOption {
+	return func(c *alphabetBuildConfig) { c.topN = n }
+}
+
+// AlphabetCoverage keeps the most common runes until they account for at
+// least this fraction of all runes seen, i.e. 0.999 for 99.9%.
+func AlphabetCoverage(fraction float64) AlphabetBuildOption {
+	return func(c *alphabetBuildConfig) { c.coverage = fraction }
+}
+
+// AlphabetIncludeCategories discards runes that are not in any of the tables,
+// i.e. AlphabetIncludeCategories(unicode.Letter, unicode.Space).
+func AlphabetIncludeCategories(tables ...*unicode.RangeTable) AlphabetBuildOption {
+	return func(c *alphabetBuildConfig) { c.include = append(c.include, tables...) }
+}
+
+// AlphabetExcludeCategories discards runes that are in any of the tables,
+// i.e. AlphabetExcludeCategories(unicode.Cc, unicode.So).
+func AlphabetExcludeCategories(tables ...*unicode.RangeTable) AlphabetBuildOption {
+	return func(c *alphabetBuildConfig) { c.exclude = append(c.exclude, tables...) }
+}
+
+// Reasons a rune may be discarded by AlphabetBuilder.Build:
+const (
+	DiscardCategory = "category"
+	DiscardMinCount = "min-count"
+	DiscardTopN     = "top-n"
+	DiscardCoverage = "coverage"
+)
+
+// AlphabetReport describes what AlphabetBuilder.Build kept and discarded.
+type AlphabetReport struct {
+	// Total number of runes counted by the builder.
+	Total int64
+
+	// Runes that made it into the Alphabet, most common first.
+	Kept []RuneCount
+
+	// Runes that did not make it into the Alphabet, most common first.
+	Discarded []DiscardedRune
+
+	// Fraction of Total accounted for by the Kept runes.
+	Coverage float64
+}
+
+type DiscardedRune struct {
+	RuneCount
+	Reason string
+}
+
+func (r *AlphabetReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "kept %d runes, discarded %d, coverage %0.4f%% of %d\n",
+		len(r.Kept), len(r.Discarded), r.Coverage*100, r.Total)
+	for _, k := range r.Kept {
+		fmt.Fprintf(&sb, "kept\t%q\t%d\n", k.Rune, k.Count)
+	}
+	for _, d := range r.Discarded {
+		fmt.Fprintf(&sb, "discarded\t%q\t%d\t%s\n", d.Rune, d.Count, d.Reason)
+	}
+	return sb.String()
+}
+
+// Build chooses an Alphabet from the counted runes. With no options, every
+// rune counted is kept. The runes in the Alphabet are in order of first
+// appearance.
+func (ab *AlphabetBuilder) Build(opts ...AlphabetBuildOption) (Alphabet, *AlphabetReport) {
+	var config alphabetBuildConfig
+	for _, o := range opts {
+		o(&config)
+	}
+
+	sorted := make([]*RuneCount, len(ab.order))
+	copy(sorted, ab.order)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Count > sorted[j].Count
+	})
+
+	report := &AlphabetReport{Total: ab.total}
+	var kept []*RuneCount
+	var covered int64
+
+	for _, rc := range sorted {
+		var reason string
+		switch {
+		case len(config.include) > 0 && !unicode.In(rc.Rune, config.include...):
+			reason = DiscardCategory
+		case len(config.exclude) > 0 && unicode.In(rc.Rune, config.exclude...):
+			reason = DiscardCategory
+		case config.minCount > 0 && rc.Count < config.minCount:
+			reason = DiscardMinCount
+		case config.topN > 0 && len(kept) >= config.topN:
+			reason = DiscardTopN
+		case config.coverage > 0 && float64(covered) >= config.coverage*float64(ab.total):
+			reason = DiscardCoverage
+		}
+
+		if reason != "" {
+			report.Discarded = append(report.Discarded, DiscardedRune{RuneCount: *rc, Reason: reason})
+		} else {
+			kept = append(kept, rc)
+			report.Kept = append(report.Kept, *rc)
+			covered += rc.Count
+		}
+	}
+
+	if ab.total > 0 {
+		report.Coverage = float64(covered) / float64(ab.total)
+	}
+
+	sort.Slice(kept, func(i, j int) bool { return kept[i].first < kept[j].first })
+	runes := make([]rune, len(kept))
+	for i, rc := range kept {
+		runes[i] = rc.Rune
+	}
+
+	return NewAlphabet(runes), report
+}
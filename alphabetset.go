@@ -0,0 +1,210 @@
+package gibberdet
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// alphabetClasses returns the runes at each position of a, including every
+// member of a class rather than just the representative in Runes().
+func alphabetClasses(a Alphabet) [][]rune {
+	if ca, ok := a.(classAlphabet); ok && ca.Classes() != nil {
+		return ca.Classes()
+	}
+	runes := a.Runes()
+	classes := make([][]rune, len(runes))
+	for i := range runes {
+		classes[i] = runes[i : i+1 : i+1]
+	}
+	return classes
+}
+
+// filterClasses returns the members of each class in a for which keep
+// returns true, dropping classes that are left empty.
+func filterClasses(a Alphabet, keep func(rn rune) bool) Alphabet {
+	var classes [][]rune
+	for _, class := range alphabetClasses(a) {
+		var kept []rune
+		for _, rn := range class {
+			if keep(rn) {
+				kept = append(kept, rn)
+			}
+		}
+		if len(kept) > 0 {
+			classes = append(classes, kept)
+		}
+	}
+	return NewClassAlphabet(classes...)
+}
+
+// AlphabetUnion returns an Alphabet containing the runes of every alphabet
+// passed, in the order they first appear. Classes are kept, less any runes
+// that appear in an earlier alphabet.
+func AlphabetUnion(alphas ...Alphabet) Alphabet {
+	var classes [][]rune
+	for _, a := range alphas {
+		classes = append(classes, alphabetClasses(a)...)
+	}
+	return NewClassAlphabet(classes...)
+}
+
+// AlphabetIntersect returns an Alphabet containing the runes of a that are
+// also in b, in the order they appear in a. Classes in a keep the members
+// that are in b.
+func AlphabetIntersect(a, b Alphabet) Alphabet {
+	return filterClasses(a, func(rn rune) bool { return b.FindRune(rn) >= 0 })
+}
+
+// AlphabetSubtract returns an Alphabet containing the runes of a that are not
+// in b, in the order they appear in a. Classes in a keep the members that are
+// not in b.
+func AlphabetSubtract(a, b Alphabet) Alphabet {
+	return filterClasses(a, func(rn rune) bool { return b.FindRune(rn) < 0 })
+}
+
+// AlphabetContains reports whether every rune in b, including every member of
+// its classes, is also in a.
+func AlphabetContains(a, b Alphabet) bool {
+	for _, class := range alphabetClasses(b) {
+		for _, rn := range class {
+			if a.FindRune(rn) < 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// AlphabetEqual reports whether a and b contain the same runes, ignoring
+// order. Models can only share alphabets that are equal and in the same
+// order; use AlphabetSpec(a) == AlphabetSpec(b) to check for that.
+func AlphabetEqual(a, b Alphabet) bool {
+	return a.Len() == b.Len() && AlphabetContains(a, b)
+}
+
+// AlphabetSpec returns a spec for a that can be passed to ParseAlphabetSpec
+// to build an identical Alphabet, with the runes in the same order. Runs of
+// three or more consecutive runes are written as ranges, and classes of more
+// than one rune are written in square brackets.
+func AlphabetSpec(a Alphabet) string {
+	var classes [][]rune
+	if ca, ok := a.(classAlphabet); ok {
+		classes = ca.Classes()
+	}
+
+	var sb strings.Builder
+	if classes == nil {
+		writeSpecRunes(&sb, a.Runes())
+		return sb.String()
+	}
+
+	var run []rune
+	for _, class := range classes {
+		if len(class) == 1 {
+			run = append(run, class[0])
+			continue
+		}
+		writeSpecRunes(&sb, run)
+		run = run[:0]
+		sb.WriteByte('[')
+		writeSpecRunes(&sb, class)
+		sb.WriteByte(']')
+	}
+	writeSpecRunes(&sb, run)
+	return sb.String()
+}
+
+func writeSpecRunes(sb *strings.Builder, runes []rune) {
+	for i := 0; i < len(runes); {
+		j := i + 1
+		for j < len(runes) && runes[j] == runes[j-1]+1 && runes[j-1] != OtherRune {
+			j++
+		}
+		writeSpecRune(sb, runes[i])
+		if j-i >= 3 {
+			sb.WriteByte('-')
+			writeSpecRune(sb, runes[j-1])
+			i = j
+		} else {
+			i++
+		}
+	}
+}
+
+func writeSpecRune(sb *strings.Builder, rn rune) {
+	switch {
+	case rn == '\\' || rn == '-' || rn == '[' || rn == ']':
+		sb.WriteByte('\\')
+		sb.WriteRune(rn)
+	case rn == OtherRune:
+		sb.WriteString(`\o`)
+	case rn == '\t':
+		sb.WriteString(`\t`)
+	case rn == '\n':
+		sb.WriteString(`\n`)
+	case rn == '\r':
+		sb.WriteString(`\r`)
+	case rn == ' ' || unicode.IsPrint(rn):
+		sb.WriteRune(rn)
+	case rn > 0xFFFF:
+		fmt.Fprintf(sb, `\U%08X`, rn)
+	default:
+		fmt.Fprintf(sb, `\u%04X`, rn)
+	}
+}
+
+func (al *runeAlphabet) String() string  { return AlphabetSpec(al) }
+func (al *asciiAlphabet) String() string { return AlphabetSpec(al) }
+
+// Project returns a copy of the model that uses alpha instead of the model's
+// alphabet. alpha must be a subset of the model's alphabet, in any order. The
+// probabilities of the transitions from each rune are re-normalised over the
+// runes in alpha, so runes can be dropped from a model without retraining.
+// Transitions that were never seen stay that way.
+//
+// The returned model uses the same Storage as m.
+func (m *Model) Project(alpha Alphabet) (*Model, error) {
+	if !AlphabetContains(m.alpha, alpha) {
+		missing := AlphabetSubtract(alpha, m.alpha)
+		return nil, fmt.Errorf("gibberdet: cannot project onto alphabet with runes not in the model: %q", string(missing.Runes()))
+	}
+
+	n := alpha.Len()
+	idx := make([]int, n)
+	for i, rn := range alpha.Runes() {
+		idx[i] = m.alpha.FindRune(rn)
+	}
+
+	gram := make([]float64, n*n)
+	for from := 0; from < n; from++ {
+		row := gram[from*n : from*n+n]
+		sharedRow(m.table, idx[from], idx, row)
+		for to, p := range row {
+			if p == 0 {
+				// Keep transitions that were never seen as the sentinel:
+				row[to] = math.SmallestNonzeroFloat64
+				continue
+			}
+			row[to] = math.Log(p)
+		}
+	}
+
+	table, err := newTable(gram, n, m.Storage())
+	if err != nil {
+		return nil, err
+	}
+	meta := m.Metadata()
+	meta[MetaAlphabet] = AlphabetKind(alpha)
+	out := &Model{
+		alpha:    alpha,
+		table:    table,
+		encoding: m.encoding,
+		charset:  m.charset,
+		compat:   m.compat,
+		meta:     meta,
+	}
+	out.init()
+	return out, nil
+}
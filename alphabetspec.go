@@ -0,0 +1,239 @@
+package gibberdet
+
+import (
+	"fmt"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// AlphabetFromRangeTables builds an Alphabet containing every rune in the
+// tables, i.e. AlphabetFromRangeTables(unicode.Latin, unicode.Mn). Runes are
+// in ascending order within each table; runes in more than one table are
+// included once.
+func AlphabetFromRangeTables(tables ...*unicode.RangeTable) Alphabet {
+	var runes []rune
+	for _, table := range tables {
+		runes = appendRangeTable(runes, table)
+	}
+	return NewAlphabet(runes)
+}
+
+// AlphabetFromCategories builds an Alphabet from the names of Unicode
+// categories, scripts or properties, as found in unicode.Categories,
+// unicode.Scripts and unicode.Properties, i.e. "Lu", "Han" or "White_Space".
+func AlphabetFromCategories(names ...string) (Alphabet, error) {
+	tables := make([]*unicode.RangeTable, 0, len(names))
+	for _, name := range names {
+		table, err := lookupRangeTable(name)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+	}
+	return AlphabetFromRangeTables(tables...), nil
+}
+
+// ParseAlphabetSpec builds an Alphabet from a compact textual spec, similar to
+// the contents of a regular expression character class:
+//
+//	a-zA-Z0-9_\- '
+//
+// A spec is a sequence of runes and ranges of runes ('a-z'). A '-' at the
+// start or end of the spec is literal. The following escapes are supported:
+//
+//	\\ \- \[ \] \t \n \r Literal backslash, dash, brackets, tab, newline, carriage return
+//	\uXXXX \U00XXXXXX    Rune by hex code point
+//	\p{Name}             All runes in the Unicode category, script or property
+//	\o                   The catch-all OTHER symbol (see WithOther)
+//
+// Runes are added to the alphabet in the order they appear in the spec. Runes
+// that appear more than once are included once.
+//
+// Runes inside square brackets are a class, and are treated as the same symbol
+// (see NewClassAlphabet), i.e. '[aA][bB][0-9] '.
+func ParseAlphabetSpec(spec string) (Alphabet, error) {
+	items, err := parseAlphabetSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	ra := newRuneAlphabet(nil)
+	for _, item := range items {
+		if item.class {
+			ra.addClass(item.runes)
+		} else {
+			for _, rn := range item.runes {
+				ra.add(rn)
+			}
+		}
+	}
+	if ra.max < 128 {
+		return ra.asASCII(), nil
+	}
+	return ra, nil
+}
+
+// specItem is either a single class made of all of its runes, or a run of
+// runes that each get their own position.
+type specItem struct {
+	runes []rune
+	class bool
+}
+
+func parseAlphabetSpec(spec string) (items []specItem, err error) {
+	pos := 0
+
+	// next reads a single rune or escape. If it reads a \p{...} class, table
+	// is set and r is meaningless.
+	next := func() (r rune, escaped bool, table *unicode.RangeTable, err error) {
+		r, sz := utf8.DecodeRuneInString(spec[pos:])
+		if r == utf8.RuneError && sz <= 1 {
+			return 0, false, nil, fmt.Errorf("gibberdet: invalid UTF-8 in alphabet spec at %d", pos)
+		}
+		pos += sz
+		if r != '\\' {
+			return r, false, nil, nil
+		}
+
+		if pos >= len(spec) {
+			return 0, false, nil, fmt.Errorf("gibberdet: trailing '\\' in alphabet spec")
+		}
+		esc := spec[pos]
+		pos++
+		switch esc {
+		case '\\', '-', '[', ']':
+			return rune(esc), true, nil, nil
+		case 't':
+			return '\t', true, nil, nil
+		case 'n':
+			return '\n', true, nil, nil
+		case 'r':
+			return '\r', true, nil, nil
+		case 'o':
+			return OtherRune, true, nil, nil
+
+		case 'u', 'U':
+			digits := 4
+			if esc == 'U' {
+				digits = 8
+			}
+			if pos+digits > len(spec) {
+				return 0, false, nil, fmt.Errorf("gibberdet: short \\%c escape in alphabet spec", esc)
+			}
+			v, err := strconv.ParseUint(spec[pos:pos+digits], 16, 32)
+			if err != nil || v > unicode.MaxRune {
+				return 0, false, nil, fmt.Errorf("gibberdet: invalid \\%c escape in alphabet spec: %q", esc, spec[pos:pos+digits])
+			}
+			pos += digits
+			return rune(v), true, nil, nil
+
+		case 'p':
+			if pos >= len(spec) || spec[pos] != '{' {
+				return 0, false, nil, fmt.Errorf("gibberdet: expected '{' after \\p in alphabet spec")
+			}
+			end := pos + 1
+			for end < len(spec) && spec[end] != '}' {
+				end++
+			}
+			if end >= len(spec) {
+				return 0, false, nil, fmt.Errorf("gibberdet: unterminated \\p{ in alphabet spec")
+			}
+			table, err := lookupRangeTable(spec[pos+1 : end])
+			if err != nil {
+				return 0, false, nil, err
+			}
+			pos = end + 1
+			return 0, true, table, nil
+
+		default:
+			return 0, false, nil, fmt.Errorf("gibberdet: unknown escape \\%c in alphabet spec", esc)
+		}
+	}
+
+	var cur *specItem
+	var inClass bool
+	for pos < len(spec) {
+		if spec[pos] == '[' && !inClass {
+			pos++
+			inClass = true
+			items = append(items, specItem{class: true})
+			cur = &items[len(items)-1]
+			continue
+		} else if spec[pos] == ']' && inClass {
+			pos++
+			inClass = false
+			cur = nil
+			continue
+		} else if cur == nil {
+			items = append(items, specItem{})
+			cur = &items[len(items)-1]
+		}
+
+		lo, _, table, err := next()
+		if err != nil {
+			return nil, err
+		}
+		if table != nil {
+			cur.runes = appendRangeTable(cur.runes, table)
+			continue
+		}
+
+		// A '-' that isn't at the end of the spec or class makes a range:
+		if pos+1 < len(spec) && spec[pos] == '-' && !(inClass && spec[pos+1] == ']') {
+			pos++
+			hi, _, table, err := next()
+			if err != nil {
+				return nil, err
+			}
+			if table != nil {
+				return nil, fmt.Errorf("gibberdet: \\p{} can not be used in a range in alphabet spec")
+			}
+			if lo == OtherRune || hi == OtherRune {
+				return nil, fmt.Errorf("gibberdet: \\o can not be used in a range in alphabet spec")
+			}
+			if hi < lo {
+				return nil, fmt.Errorf("gibberdet: invalid range %q-%q in alphabet spec", lo, hi)
+			}
+			for r := lo; r <= hi; r++ {
+				cur.runes = append(cur.runes, r)
+			}
+			continue
+		}
+
+		cur.runes = append(cur.runes, lo)
+	}
+
+	if inClass {
+		return nil, fmt.Errorf("gibberdet: unterminated '[' in alphabet spec")
+	}
+
+	return items, nil
+}
+
+func lookupRangeTable(name string) (*unicode.RangeTable, error) {
+	if t := unicode.Categories[name]; t != nil {
+		return t, nil
+	}
+	if t := unicode.Scripts[name]; t != nil {
+		return t, nil
+	}
+	if t := unicode.Properties[name]; t != nil {
+		return t, nil
+	}
+	return nil, fmt.Errorf("gibberdet: unknown unicode category, script or property %q", name)
+}
+
+func appendRangeTable(runes []rune, table *unicode.RangeTable) []rune {
+	for _, r16 := range table.R16 {
+		for r := rune(r16.Lo); r <= rune(r16.Hi); r += rune(r16.Stride) {
+			runes = append(runes, r)
+		}
+	}
+	for _, r32 := range table.R32 {
+		for r := rune(r32.Lo); r <= rune(r32.Hi); r += rune(r32.Stride) {
+			runes = append(runes, r)
+		}
+	}
+	return runes
+}
@@ -0,0 +1,86 @@
+package gibberdet
+
+import "unicode"
+
+// Augmentation transforms the training input rune-by-rune as it passes through
+// Trainer.Add. Each augmentation sees the same input as the trainer, but counts
+// the transitions in its own transformed sequence, weighted by Weight, so a
+// single pass over the input can teach the model about several surface forms
+// of the same text (snake_case, kebab-case, camelCase, ALL CAPS, etc).
+type Augmentation struct {
+	// Name is used for reporting only.
+	Name string
+
+	// Amount to add to a transition's count each time it is observed in the
+	// transformed sequence. The untransformed input always uses 1.
+	Weight float64
+
+	// Map is called with the previous input rune (0 at the start of the
+	// input) and the current input rune. It returns the rune to count in
+	// place of r, or ok=false to drop r from the transformed sequence
+	// without breaking it.
+	Map func(prev, r rune) (out rune, ok bool)
+}
+
+// AugmentReplaceSpace replaces each run of whitespace with a single 'with'
+// rune, i.e. AugmentReplaceSpace('_', 1) for snake_case and
+// AugmentReplaceSpace('-', 1) for kebab-case.
+func AugmentReplaceSpace(with rune, weight float64) Augmentation {
+	return Augmentation{
+		Name:   "replace-space:" + string(with),
+		Weight: weight,
+		Map: func(prev, r rune) (rune, bool) {
+			if unicode.IsSpace(r) {
+				if unicode.IsSpace(prev) {
+					return 0, false
+				}
+				return with, true
+			}
+			return r, true
+		},
+	}
+}
+
+// AugmentCamelCase joins words together, upper-casing the first rune after
+// each run of whitespace: "hello world" becomes "helloWorld".
+func AugmentCamelCase(weight float64) Augmentation {
+	return Augmentation{
+		Name:   "camel",
+		Weight: weight,
+		Map: func(prev, r rune) (rune, bool) {
+			if unicode.IsSpace(r) {
+				return 0, false
+			}
+			if unicode.IsSpace(prev) {
+				return unicode.ToUpper(r), true
+			}
+			return r, true
+		},
+	}
+}
+
+// AugmentUpper upper-cases the entire input.
+func AugmentUpper(weight float64) Augmentation {
+	return Augmentation{
+		Name:   "upper",
+		Weight: weight,
+		Map: func(prev, r rune) (rune, bool) {
+			return unicode.ToUpper(r), true
+		},
+	}
+}
+
+// AugmentTitle upper-cases the first rune of each word and lower-cases the
+// rest: "hello WORLD" becomes "Hello World".
+func AugmentTitle(weight float64) Augmentation {
+	return Augmentation{
+		Name:   "title",
+		Weight: weight,
+		Map: func(prev, r rune) (rune, bool) {
+			if prev == 0 || unicode.IsSpace(prev) {
+				return unicode.ToTitle(r), true
+			}
+			return unicode.ToLower(r), true
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package gibberdet
+
+// ByteAlphabet contains all 256 byte values, for judging strings that are not
+// UTF-8 text, like cookies, tokens or encoded query parameters. Models that
+// use it score raw bytes with a 256x256 table and never decode UTF-8, and the
+// Trainer reads its input a byte at a time.
+//
+// The bytes appear in Runes() as the runes 0 to 255, and FindRune returns the
+// position of the byte with the same value. ByteAlphabet can't be combined with
+// other alphabets, or used with WithOther or NewClassAlphabet.
+var ByteAlphabet Alphabet = &byteAlphabet{}
+
+type byteAlphabet struct{}
+
+var byteAlphabetRunes = func() []rune {
+	runes := make([]rune, 256)
+	for i := range runes {
+		runes[i] = rune(i)
+	}
+	return runes
+}()
+
+func (al *byteAlphabet) Runes() []rune {
+	return byteAlphabetRunes
+}
+
+func (al *byteAlphabet) Len() int {
+	return 256
+}
+
+func (al *byteAlphabet) FindByte(b byte) (pos int) {
+	return int(b)
+}
+
+func (al *byteAlphabet) FindRune(rn rune) (pos int) {
+	if rn < 0 || rn > 255 {
+		return -1
+	}
+	return int(rn)
+}
+
+func (al *byteAlphabet) String() string { return "bytes" }
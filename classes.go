@@ -0,0 +1,67 @@
+package gibberdet
+
+import "unicode"
+
+// Common rune classes for use with NewClassAlphabet.
+var (
+	// DigitClass treats all ASCII digits as a single symbol.
+	DigitClass = []rune(numeric)
+
+	// SpaceClass treats all ASCII whitespace as a single space.
+	SpaceClass = []rune(" \t\n\r\v\f")
+)
+
+var (
+	// ASCIIAlphaFolded is ASCIIAlpha with upper and lower case letters treated
+	// as the same symbol, and all ASCII whitespace treated as a space.
+	ASCIIAlphaFolded = NewClassAlphabet(append(FoldCase([]rune(alphaLower)), SpaceClass)...)
+
+	// ASCIIAlnumFolded is ASCIIAlphaFolded, plus all digits as a single
+	// symbol.
+	ASCIIAlnumFolded = NewClassAlphabet(append(FoldCase([]rune(alphaLower)), DigitClass, SpaceClass)...)
+)
+
+const alphaLower = "abcdefghijklmnopqrstuvwxyz"
+
+// NewClassAlphabet builds an Alphabet in which each class is a set of runes
+// that are treated as the same symbol, i.e. 'a' and 'A', or '0' to '9'. Each
+// class takes one position in the alphabet, so Len() is the number of
+// classes, and Runes() returns the first rune of each class.
+//
+// A rune that appears in more than one class belongs to the first. A class
+// with no runes that are not in an earlier class is ignored.
+//
+// The mapping is used everywhere the Alphabet is: by the Trainer, by both
+// scoring paths, and it is preserved when the Model is serialised.
+func NewClassAlphabet(classes ...[]rune) Alphabet {
+	ra := newRuneAlphabet(nil)
+	for _, class := range classes {
+		ra.addClass(class)
+	}
+	if ra.max < 128 {
+		return ra.asASCII()
+	}
+	return ra
+}
+
+// FoldCase returns a class for each rune, containing the rune and its upper,
+// lower and title case forms. Runes that are already in an earlier class are
+// skipped, so FoldCase([]rune("abAB")) returns [['a', 'A'], ['b', 'B']].
+func FoldCase(runes []rune) (classes [][]rune) {
+	seen := make(map[rune]bool, len(runes)*2)
+	for _, rn := range runes {
+		if seen[rn] {
+			continue
+		}
+		class := []rune{rn}
+		seen[rn] = true
+		for _, v := range []rune{unicode.ToLower(rn), unicode.ToUpper(rn), unicode.ToTitle(rn)} {
+			if !seen[v] {
+				class = append(class, v)
+				seen[v] = true
+			}
+		}
+		classes = append(classes, class)
+	}
+	return classes
+}
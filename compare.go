@@ -0,0 +1,150 @@
+package gibberdet
+
+import (
+	"math"
+	"sort"
+)
+
+// Number of transitions reported in ModelComparison.TopChanges.
+const compareTopChanges = 20
+
+// ModelComparison describes the differences between two models. See
+// CompareModels.
+type ModelComparison struct {
+	// Runes that are in both alphabets, in the order they appear in model A's
+	// alphabet. All divergences are calculated over these runes only.
+	Shared []rune
+
+	// Runes that are only in one of the alphabets.
+	OnlyA []rune
+	OnlyB []rune
+
+	// Divergence of each row of the transition matrix, in the same order as
+	// Shared.
+	Rows []RowDivergence
+
+	// Mean of the Jensen-Shannon divergence of all rows.
+	MeanJS float64
+
+	// Transitions with the largest absolute change in probability, largest
+	// first.
+	TopChanges []TransitionChange
+
+	// Scores for each sample passed to CompareModels.
+	Scores []ScoreDiff
+}
+
+// RowDivergence is the divergence between the probability distributions of
+// the runes that follow Rune in each model, using the natural log.
+type RowDivergence struct {
+	Rune rune
+
+	// Kullback-Leibler divergence of B from A. This is +Inf if B assigns a
+	// zero probability to a transition that A does not.
+	KL float64
+
+	// Jensen-Shannon divergence, which is symmetric and always finite.
+	JS float64
+}
+
+type TransitionChange struct {
+	From, To     rune
+	ProbA, ProbB float64
+}
+
+type ScoreDiff struct {
+	Input string
+	A, B  float64
+}
+
+// CompareModels aligns the alphabets of a and b and compares their transition
+// probabilities. Each row is re-normalised to the runes shared by both
+// alphabets so that the rows can be compared as probability distributions.
+//
+// If any samples are passed, they are scored with both models and the results
+// returned in ModelComparison.Scores.
+func CompareModels(a, b *Model, samples ...string) *ModelComparison {
+	cmp := &ModelComparison{}
+
+	var aIdx, bIdx []int
+	for i, r := range a.alpha.Runes() {
+		if j := b.alpha.FindRune(r); j >= 0 {
+			cmp.Shared = append(cmp.Shared, r)
+			aIdx = append(aIdx, i)
+			bIdx = append(bIdx, j)
+		} else {
+			cmp.OnlyA = append(cmp.OnlyA, r)
+		}
+	}
+	for _, r := range b.alpha.Runes() {
+		if a.alpha.FindRune(r) < 0 {
+			cmp.OnlyB = append(cmp.OnlyB, r)
+		}
+	}
+
+	n := len(cmp.Shared)
+	pa, pb := make([]float64, n), make([]float64, n)
+	var changes []TransitionChange
+
+	for from := 0; from < n; from++ {
+		sharedRow(a.table, aIdx[from], aIdx, pa)
+		sharedRow(b.table, bIdx[from], bIdx, pb)
+
+		row := RowDivergence{Rune: cmp.Shared[from]}
+		for to := 0; to < n; to++ {
+			row.KL += klTerm(pa[to], pb[to])
+			m := (pa[to] + pb[to]) / 2
+			row.JS += (klTerm(pa[to], m) + klTerm(pb[to], m)) / 2
+
+			if pa[to] != pb[to] {
+				changes = append(changes, TransitionChange{
+					From: cmp.Shared[from], To: cmp.Shared[to],
+					ProbA: pa[to], ProbB: pb[to],
+				})
+			}
+		}
+		cmp.Rows = append(cmp.Rows, row)
+		cmp.MeanJS += row.JS
+	}
+	if n > 0 {
+		cmp.MeanJS /= float64(n)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return math.Abs(changes[i].ProbA-changes[i].ProbB) > math.Abs(changes[j].ProbA-changes[j].ProbB)
+	})
+	if len(changes) > compareTopChanges {
+		changes = changes[:compareTopChanges]
+	}
+	cmp.TopChanges = changes
+
+	for _, s := range samples {
+		cmp.Scores = append(cmp.Scores, ScoreDiff{Input: s, A: a.GibberScore(s), B: b.GibberScore(s)})
+	}
+
+	return cmp
+}
+
+// sharedRow fills out with the probabilities of the transitions from the rune
+// at alphabet index 'from' to each of the runes at the alphabet indexes in
+// cols, normalised so they sum to 1. Transitions that were never seen have a
+// probability of 0.
+func sharedRow(table gramTable, from int, cols []int, out []float64) {
+	var sum float64
+	for i, to := range cols {
+		out[i] = transitionProb(table.logProb(from, to))
+		sum += out[i]
+	}
+	if sum > 0 {
+		for i := range out {
+			out[i] /= sum
+		}
+	}
+}
+
+func klTerm(p, q float64) float64 {
+	if p == 0 {
+		return 0
+	}
+	return p * math.Log(p/q)
+}
@@ -0,0 +1,87 @@
+package gibberdet
+
+import (
+	"io/ioutil"
+	"math"
+	"testing"
+)
+
+func loadTestModel(t testing.TB, name string) *Model {
+	t.Helper()
+	b, err := ioutil.ReadFile("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m Model
+	if err := m.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	return &m
+}
+
+func TestCompareModels(t *testing.T) {
+	oanc := loadTestModel(t, "oanc-en.gibber")
+	gutenberg := loadTestModel(t, "gutenberg-en.gibber")
+
+	same := CompareModels(oanc, oanc, "hello")
+	if same.MeanJS != 0 || len(same.TopChanges) != 0 || len(same.OnlyA) != 0 || len(same.OnlyB) != 0 {
+		t.Fatal(same.MeanJS, same.TopChanges)
+	}
+	if same.Scores[0].A != same.Scores[0].B {
+		t.Fatal(same.Scores)
+	}
+
+	cmp := CompareModels(oanc, gutenberg, "hello", "2c38qnuonuf")
+	if len(cmp.Shared)+len(cmp.OnlyA) != oanc.alpha.Len() || len(cmp.Shared)+len(cmp.OnlyB) != gutenberg.alpha.Len() {
+		t.Fatal(len(cmp.Shared), len(cmp.OnlyA), len(cmp.OnlyB))
+	}
+	if cmp.MeanJS <= 0 || cmp.MeanJS > math.Ln2 {
+		t.Fatal(cmp.MeanJS)
+	}
+	for _, row := range cmp.Rows {
+		if row.JS < 0 || row.JS > math.Ln2+1e-12 || row.KL < 0 {
+			t.Fatal(row)
+		}
+	}
+	if len(cmp.TopChanges) != compareTopChanges {
+		t.Fatal(len(cmp.TopChanges))
+	}
+	first, last := cmp.TopChanges[0], cmp.TopChanges[len(cmp.TopChanges)-1]
+	if math.Abs(first.ProbA-first.ProbB) < math.Abs(last.ProbA-last.ProbB) {
+		t.Fatal(first, last)
+	}
+	if len(cmp.Scores) != 2 || cmp.Scores[1].Input != "2c38qnuonuf" {
+		t.Fatal(cmp.Scores)
+	}
+}
+
+func TestSharedRowSentinel(t *testing.T) {
+	oanc := loadTestModel(t, "oanc-en.gibber")
+	n := oanc.alpha.Len()
+	cols := make([]int, n)
+	for i := range cols {
+		cols[i] = i
+	}
+	row := make([]float64, n)
+
+	var sentinels int
+	for from := 0; from < n; from++ {
+		sharedRow(oanc.table, from, cols, row)
+		var sum float64
+		for to, p := range row {
+			if oanc.table.logProb(from, to) > 0 {
+				sentinels++
+				if p != 0 {
+					t.Fatal(from, to, p)
+				}
+			}
+			sum += p
+		}
+		if math.Abs(sum-1) > 1e-12 {
+			t.Fatal(from, sum)
+		}
+	}
+	if sentinels == 0 {
+		t.Fatal("expected oanc to contain transitions that were never seen")
+	}
+}
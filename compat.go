@@ -0,0 +1,148 @@
+package gibberdet
+
+import (
+	"fmt"
+	"math"
+	"unicode/utf8"
+)
+
+// CompatMode selects whether the Trainer and the Model follow this package's
+// own rules, or those of another implementation of the algorithm. See
+// TrainerCompat and Model.WithCompat.
+type CompatMode int
+
+const (
+	CompatNone CompatMode = iota
+
+	// CompatPython follows rrenaud's original Python Gibberish-Detector,
+	// which this package is a port of:
+	//
+	//  - ASCII letters are lowercased before they are looked up in the
+	//    alphabet, like Python 2's str.lower().
+	//  - Runes that are not in the alphabet are dropped, and the runes on
+	//    either side are treated as a transition. Without CompatPython, they
+	//    break the sequence when training, and cost a fixed penalty when
+	//    scoring.
+	//  - The Trainer treats each line as a separate sequence.
+	//  - Scores use math.Exp rather than a faster approximation, and are
+	//    averaged over the number of transitions rather than the length of
+	//    the input. Inputs with no transitions score 1, not 0.
+	//
+	// Use it with PythonAlphabet to get the same scores as the Python code.
+	// ReadPythonModel returns models that use it.
+	CompatPython
+)
+
+var compatNames = map[CompatMode]string{
+	CompatNone:   "none",
+	CompatPython: "python",
+}
+
+// ParseCompatMode accepts the names returned by CompatMode.String().
+func ParseCompatMode(s string) (CompatMode, error) {
+	for k, v := range compatNames {
+		if v == s {
+			return k, nil
+		}
+	}
+	return 0, fmt.Errorf("gibberdet: unknown compat mode %q", s)
+}
+
+func (c CompatMode) String() string {
+	if name, ok := compatNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("CompatMode(%d)", int(c))
+}
+
+func (c CompatMode) check(alpha Alphabet) error {
+	switch c {
+	case CompatNone:
+		return nil
+	case CompatPython:
+		if _, ok := alpha.(*byteAlphabet); ok {
+			return fmt.Errorf("gibberdet: CompatPython does not support ByteAlphabet")
+		}
+		if alpha.FindRune(OtherRune) >= 0 {
+			return fmt.Errorf("gibberdet: CompatPython does not support OTHER")
+		}
+		return nil
+	default:
+		return fmt.Errorf("gibberdet: unknown compat mode %d", int(c))
+	}
+}
+
+// TrainerCompat makes the Trainer, and the models it compiles, follow another
+// implementation's rules. The default is CompatNone. Compile returns an error
+// if the alphabet is not supported by the mode.
+func TrainerCompat(c CompatMode) TrainerOption {
+	return func(t *Trainer) {
+		t.compat = c
+	}
+}
+
+// Compat reports the CompatMode the model scores its input with.
+func (m *Model) Compat() CompatMode {
+	return m.compat
+}
+
+// WithCompat returns a copy of the model that scores its input following the
+// rules of c. It is stored in the metadata as MetaCompat. The transitions are
+// shared with m.
+func (m *Model) WithCompat(c CompatMode) (*Model, error) {
+	if err := c.check(m.alpha); err != nil {
+		return nil, err
+	}
+	value := c.String()
+	if c == CompatNone {
+		value = ""
+	}
+	out := m.WithMetadata(map[string]string{MetaCompat: value})
+	out.compat = c
+	out.init()
+	return out, nil
+}
+
+// compatLower lowercases ASCII letters, like Python 2's str.lower().
+func compatLower(r rune) rune {
+	if r >= 'A' && r <= 'Z' {
+		return r + ('a' - 'A')
+	}
+	return r
+}
+
+// gibberStringScorePython follows avg_transition_prob from the Python
+// Gibberish-Detector; see CompatPython.
+func (m *Model) gibberStringScorePython(s string) float64 {
+	var logProb float64
+	var transitions int
+	last := -1
+
+	for i := 0; i < len(s); {
+		r, sz := rune(0), 1
+		if m.charset != nil {
+			r = m.charset[s[i]]
+		} else {
+			r, sz = utf8.DecodeRuneInString(s[i:])
+		}
+		i += sz
+		if r == utf8.RuneError && sz == 1 {
+			continue
+		}
+
+		alphaIdx := m.alpha.FindRune(compatLower(r))
+		if alphaIdx < 0 {
+			continue
+		}
+		if last >= 0 {
+			logProb += m.table.logProb(last, alphaIdx)
+			transitions++
+		}
+		last = alphaIdx
+	}
+
+	if transitions == 0 {
+		return 1
+	}
+	return math.Exp(logProb / float64(transitions))
+}
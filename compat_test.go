@@ -0,0 +1,225 @@
+package gibberdet
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"os"
+	"strings"
+	"testing"
+)
+
+// testdata/parity/parity.json is written by reference.py, from the same
+// directory, which follows rrenaud's Gibberish-Detector. It holds the matrix
+// trained on corpus.txt, the threshold for good.txt and bad.txt, and the
+// scores of those and edge.txt. The samples are Latin-1, so that each byte
+// survives the trip through JSON.
+type parityJSON struct {
+	Mat    [][]float64          `json:"mat"`
+	Thresh float64              `json:"thresh"`
+	Scores [][2]json.RawMessage `json:"scores"`
+}
+
+type paritySample struct {
+	in    string
+	score float64
+}
+
+func loadParity(t *testing.T) (ref parityJSON, samples []paritySample) {
+	t.Helper()
+	bts, err := ioutil.ReadFile("testdata/parity/parity.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(bts, &ref); err != nil {
+		t.Fatal(err)
+	}
+	for _, pair := range ref.Scores {
+		var in string
+		var s paritySample
+		if err := json.Unmarshal(pair[0], &in); err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(pair[1], &s.score); err != nil {
+			t.Fatal(err)
+		}
+		latin1 := make([]byte, 0, len(in))
+		for _, r := range in {
+			latin1 = append(latin1, byte(r))
+		}
+		s.in = string(latin1)
+		samples = append(samples, s)
+	}
+	return ref, samples
+}
+
+func paritySamples(t *testing.T, name string) []string {
+	t.Helper()
+	bts, err := ioutil.ReadFile("testdata/parity/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Split(strings.TrimSuffix(string(bts), "\n"), "\n")
+}
+
+// Go's math.Log and math.Exp may differ from the C library's in the last bit.
+func parityEqual(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-12*math.Max(math.Abs(a), math.Abs(b))
+}
+
+func TestCompatPythonParity(t *testing.T) {
+	ref, samples := loadParity(t)
+	good, bad := paritySamples(t, "good.txt"), paritySamples(t, "bad.txt")
+
+	n := PythonAlphabet.Len()
+	gram := make([]float64, 0, n*n)
+	for _, row := range ref.Mat {
+		gram = append(gram, row...)
+	}
+	imported, err := NewModelFromData(&ModelData{
+		Alphabet: AlphabetSpec(PythonAlphabet),
+		Storage:  StorageDense,
+		Gram:     gram,
+		Metadata: map[string]string{MetaCompat: "python"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, enc := range []Encoding{EncodingUTF8, EncodingLatin1} {
+		f, err := os.Open("testdata/parity/corpus.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		tr := NewTrainer(PythonAlphabet, TrainerCompat(CompatPython), TrainerEncoding(enc))
+		err = tr.Add(f)
+		f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		trained, err := tr.Compile()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, p := range trained.gram {
+			if !parityEqual(p, gram[i]) {
+				t.Fatalf("%s: %q -> %q: %g, expected %g", enc,
+					PythonAlphabet.Runes()[i/n], PythonAlphabet.Runes()[i%n], p, gram[i])
+			}
+		}
+
+		for _, m := range []*Model{trained, imported} {
+			if enc == EncodingLatin1 {
+				if m, err = m.WithEncoding(enc); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, s := range samples {
+				if score := m.GibberScore(s.in); !parityEqual(score, s.score) {
+					t.Fatalf("%s: %q scored %g, expected %g", enc, s.in, score, s.score)
+				}
+			}
+			thresh, err := m.Test(good, bad)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !parityEqual(thresh, ref.Thresh) {
+				t.Fatal(enc, thresh, ref.Thresh)
+			}
+		}
+	}
+
+	// Without CompatPython, the scores are different:
+	plain, err := imported.WithCompat(CompatNone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plain.GibberScore("Hello, World") == imported.GibberScore("Hello, World") {
+		t.Fatal()
+	}
+}
+
+func TestCompatMode(t *testing.T) {
+	for _, c := range []CompatMode{CompatNone, CompatPython} {
+		if p, err := ParseCompatMode(c.String()); err != nil || p != c {
+			t.Fatal(c, p, err)
+		}
+	}
+	if _, err := ParseCompatMode("perl"); err == nil {
+		t.Fatal()
+	}
+
+	tr := NewTrainer(PythonAlphabet)
+	if err := tr.Add(strings.NewReader("hello world")); err != nil {
+		t.Fatal(err)
+	}
+	m, err := tr.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.Metadata()[MetaCompat]; ok || m.Compat() != CompatNone {
+		t.Fatal(m.Metadata())
+	}
+
+	py, err := m.WithCompat(CompatPython)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Compat() != CompatNone || py.Metadata()[MetaCompat] != "python" {
+		t.Fatal(py.Metadata())
+	}
+	if py.GibberScore("") != 1 || py.GibberScore("h!") != 1 || py.GibberScore("h!e") != py.GibberScore("he") {
+		t.Fatal()
+	}
+
+	bts, err := py.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var load Model
+	if err := load.UnmarshalBinary(bts); err != nil {
+		t.Fatal(err)
+	}
+	if load.Compat() != CompatPython || load.GibberScore("hello, world") != py.GibberScore("hello, world") {
+		t.Fatal(load.Compat())
+	}
+	for _, s := range []Storage{StorageSparse, StorageQuant8} {
+		conv, err := py.Convert(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if conv.Compat() != CompatPython {
+			t.Fatal(s)
+		}
+	}
+
+	none, err := py.WithCompat(CompatNone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := none.Metadata()[MetaCompat]; ok || none.Compat() != CompatNone {
+		t.Fatal(none.Metadata())
+	}
+
+	if _, err := m.WithCompat(CompatMode(99)); err == nil {
+		t.Fatal()
+	}
+	for _, alpha := range []Alphabet{ByteAlphabet, WithOther(ASCIIAlpha)} {
+		tr := NewTrainer(alpha, TrainerCompat(CompatPython))
+		if _, err := tr.Compile(); err == nil {
+			t.Fatal(AlphabetKind(alpha))
+		}
+	}
+}
+
+func TestTrainerCompatPython(t *testing.T) {
+	tr := NewTrainer(NewAlphabet([]rune("ab")), TrainerCompat(CompatPython), TrainerPairWeight(0))
+	if err := tr.Add(strings.NewReader("A-b\nba")); err != nil {
+		t.Fatal(err)
+	}
+	// 'a' -> 'b' across the unknown rune, and 'b' -> 'a', but not across the
+	// line break:
+	if tr.gram[0*2+1] != 1 || tr.gram[1*2+0] != 1 || tr.gram[1*2+1] != 0 || tr.gram[0] != 0 {
+		t.Fatal(tr.gram)
+	}
+}
@@ -0,0 +1,337 @@
+package gibberdet
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+// CorpusFile is passed to the CorpusProgress callback once for each file
+// found by Trainer.AddPath, whether it was used or not.
+type CorpusFile struct {
+	// Path to the file. For files inside an archive, this is the path to the
+	// archive, followed by a '!', followed by the path inside the archive.
+	Path string
+
+	// Number of bytes passed to Trainer.Add. This is after decompression.
+	Bytes int64
+
+	// If the file was skipped, SkipReason explains why.
+	Skipped    bool
+	SkipReason string
+}
+
+type corpusConfig struct {
+	include     []string
+	exclude     []string
+	progress    func(CorpusFile)
+	filter      func(name string) InputFilter
+	allowBinary bool
+}
+
+type CorpusOption func(c *corpusConfig)
+
+// CorpusInclude restricts Trainer.AddPath to files matching at least one of
+// the globs. Globs are matched using path.Match against the file's base name,
+// or against the slash-separated path relative to the root passed to AddPath
+// if the glob contains a '/'.
+func CorpusInclude(globs ...string) CorpusOption {
+	return func(c *corpusConfig) {
+		c.include = append(c.include, globs...)
+	}
+}
+
+// CorpusExclude skips files matching any of the globs. Exclusions take
+// priority over inclusions. See CorpusInclude for matching rules.
+func CorpusExclude(globs ...string) CorpusOption {
+	return func(c *corpusConfig) {
+		c.exclude = append(c.exclude, globs...)
+	}
+}
+
+// CorpusProgress calls fn after each file is processed or skipped.
+func CorpusProgress(fn func(CorpusFile)) CorpusOption {
+	return func(c *corpusConfig) {
+		c.progress = fn
+	}
+}
+
+// CorpusFilter calls choose with the name of each file to pick an InputFilter
+// for it, i.e. CorpusFilter(FilterByExt). If choose returns nil, the file is
+// not filtered.
+func CorpusFilter(choose func(name string) InputFilter) CorpusOption {
+	return func(c *corpusConfig) {
+		c.filter = choose
+	}
+}
+
+// CorpusAllowBinary disables the heuristic that skips files that do not
+// look like text.
+func CorpusAllowBinary(allow bool) CorpusOption {
+	return func(c *corpusConfig) {
+		c.allowBinary = allow
+	}
+}
+
+// AddPath trains from a file or a directory tree. Directories are walked
+// recursively. Files with the extensions '.zip', '.tar', '.tar.gz', '.tgz'
+// and '.gz' are decompressed and their contents used, everything else is
+// passed to Trainer.Add as-is.
+//
+// Include and exclude globs are applied to the files inside archives as well
+// as to the files on disk; archives themselves are always opened.
+func (t *Trainer) AddPath(root string, opts ...CorpusOption) error {
+	var config corpusConfig
+	for _, o := range opts {
+		o(&config)
+	}
+
+	info, err := os.Stat(root)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return t.addCorpusFile(&config, root, filepath.Base(root))
+	}
+
+	return filepath.Walk(root, func(file string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
+		}
+		return t.addCorpusFile(&config, file, filepath.ToSlash(rel))
+	})
+}
+
+func (t *Trainer) addCorpusFile(config *corpusConfig, file string, rel string) error {
+	lower := strings.ToLower(file)
+	switch {
+	case strings.HasSuffix(lower, ".zip"):
+		return t.addCorpusZip(config, file)
+
+	case strings.HasSuffix(lower, ".tar"):
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return t.addCorpusTar(config, file, f)
+
+	case strings.HasSuffix(lower, ".tar.gz"), strings.HasSuffix(lower, ".tgz"):
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("gibberdet: corpus %q: %w", file, err)
+		}
+		defer gz.Close()
+		return t.addCorpusTar(config, file, gz)
+
+	case strings.HasSuffix(lower, ".gz"):
+		rel = rel[:len(rel)-len(".gz")]
+		if !config.match(rel) {
+			return config.skip(file, "excluded")
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return fmt.Errorf("gibberdet: corpus %q: %w", file, err)
+		}
+		defer gz.Close()
+		return t.addCorpusReader(config, file, gz)
+
+	default:
+		if !config.match(rel) {
+			return config.skip(file, "excluded")
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return t.addCorpusReader(config, file, f)
+	}
+}
+
+func (t *Trainer) addCorpusZip(config *corpusConfig, file string) error {
+	zr, err := zip.OpenReader(file)
+	if err != nil {
+		return fmt.Errorf("gibberdet: corpus %q: %w", file, err)
+	}
+	defer zr.Close()
+
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() {
+			continue
+		}
+		name := file + "!" + zf.Name
+		if !config.match(zf.Name) {
+			if err := config.skip(name, "excluded"); err != nil {
+				return err
+			}
+			continue
+		}
+
+		rc, err := zf.Open()
+		if err != nil {
+			return fmt.Errorf("gibberdet: corpus %q: %w", name, err)
+		}
+		err = t.addCorpusReader(config, name, rc)
+		rc.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Trainer) addCorpusTar(config *corpusConfig, file string, rdr io.Reader) error {
+	tr := tar.NewReader(rdr)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fmt.Errorf("gibberdet: corpus %q: %w", file, err)
+		}
+		if !hdr.FileInfo().Mode().IsRegular() {
+			continue
+		}
+		name := file + "!" + hdr.Name
+		if !config.match(hdr.Name) {
+			if err := config.skip(name, "excluded"); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := t.addCorpusReader(config, name, tr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *Trainer) addCorpusReader(config *corpusConfig, name string, rdr io.Reader) error {
+	buf := bufio.NewReaderSize(rdr, binarySniffLen)
+	if !config.allowBinary {
+		head, err := buf.Peek(binarySniffLen)
+		if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+			return fmt.Errorf("gibberdet: corpus %q: %w", name, err)
+		}
+		if looksBinary(head) {
+			return config.skip(name, "binary")
+		}
+	}
+
+	var in io.Reader = buf
+	if config.filter != nil {
+		if filter := config.filter(name); filter != nil {
+			in = filter(in)
+		}
+	}
+
+	cr := &countingReader{rdr: in}
+	if err := t.Add(cr); err != nil {
+		return fmt.Errorf("gibberdet: corpus %q: %w", name, err)
+	}
+	if config.progress != nil {
+		config.progress(CorpusFile{Path: name, Bytes: cr.n})
+	}
+	return nil
+}
+
+func (c *corpusConfig) skip(name string, reason string) error {
+	if c.progress != nil {
+		c.progress(CorpusFile{Path: name, Skipped: true, SkipReason: reason})
+	}
+	return nil
+}
+
+func (c *corpusConfig) match(rel string) bool {
+	rel = filepath.ToSlash(rel)
+	if len(c.include) > 0 && !matchGlobs(c.include, rel) {
+		return false
+	}
+	return !matchGlobs(c.exclude, rel)
+}
+
+func matchGlobs(globs []string, rel string) bool {
+	base := path.Base(rel)
+	for _, g := range globs {
+		against := base
+		if strings.Contains(g, "/") {
+			against = rel
+		}
+		if ok, _ := path.Match(g, against); ok {
+			return true
+		}
+	}
+	return false
+}
+
+const binarySniffLen = 8000
+
+// looksBinary uses a similar heuristic to git and diff: any NUL byte means
+// binary. Failing that, if more than 10% of the sample is control characters
+// or invalid UTF-8, it's considered binary too.
+func looksBinary(head []byte) bool {
+	if len(head) == 0 {
+		return false
+	}
+
+	var bad int
+	for i := 0; i < len(head); {
+		b := head[i]
+		if b == 0 {
+			return true
+		}
+		if b < utf8.RuneSelf {
+			if b < 0x20 && b != '\n' && b != '\r' && b != '\t' && b != '\f' && b != '\v' {
+				bad++
+			}
+			i++
+			continue
+		}
+		r, sz := utf8.DecodeRune(head[i:])
+		if r == utf8.RuneError && sz <= 1 {
+			// A rune truncated by the end of the sample isn't a sign of anything:
+			if len(head)-i >= utf8.UTFMax {
+				bad++
+			}
+		}
+		i += sz
+	}
+	return bad*10 > len(head)
+}
+
+type countingReader struct {
+	rdr io.Reader
+	n   int64
+}
+
+func (c *countingReader) Read(b []byte) (n int, err error) {
+	n, err = c.rdr.Read(b)
+	c.n += int64(n)
+	return n, err
+}
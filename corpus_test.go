@@ -0,0 +1,119 @@
+package gibberdet
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func writeCorpusFixtures(t *testing.T, dir string) {
+	t.Helper()
+
+	write := func(name string, data []byte) {
+		if err := os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	write("a.txt", []byte("ab"))
+	write("sub/b.txt", []byte("ab"))
+	write("sub/c.md", []byte("ab"))
+	write("bin.txt", []byte("ab\x00\x01\x02"))
+
+	{
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		gz.Write([]byte("ab"))
+		gz.Close()
+		write("d.txt.gz", buf.Bytes())
+	}
+
+	{
+		var buf bytes.Buffer
+		zw := zip.NewWriter(&buf)
+		for _, n := range []string{"e.txt", "f.csv"} {
+			w, _ := zw.Create(n)
+			w.Write([]byte("ab"))
+		}
+		zw.Close()
+		write("g.zip", buf.Bytes())
+	}
+
+	{
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		for _, n := range []string{"h.txt", "i.txt"} {
+			tw.WriteHeader(&tar.Header{Name: n, Mode: 0600, Size: 2, Typeflag: tar.TypeReg})
+			tw.Write([]byte("ab"))
+		}
+		tw.Close()
+		gz.Close()
+		write("j.tar.gz", buf.Bytes())
+	}
+}
+
+func TestTrainerAddPath(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeCorpusFixtures(t, dir)
+
+	a := NewAlphabet([]rune("ab"))
+	tr := NewTrainer(a, TrainerPairWeight(0))
+
+	var added, skipped []string
+	if err := tr.AddPath(dir,
+		CorpusInclude("*.txt"),
+		CorpusExclude("i.txt"),
+		CorpusProgress(func(f CorpusFile) {
+			rel, _ := filepath.Rel(dir, f.Path)
+			if f.Skipped {
+				skipped = append(skipped, filepath.ToSlash(rel)+":"+f.SkipReason)
+			} else {
+				added = append(added, filepath.ToSlash(rel))
+			}
+		}),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	sort.Strings(added)
+	sort.Strings(skipped)
+
+	expectedAdded := []string{"a.txt", "d.txt.gz", "g.zip!e.txt", "j.tar.gz!h.txt", "sub/b.txt"}
+	expectedSkipped := []string{"bin.txt:binary", "g.zip!f.csv:excluded", "j.tar.gz!i.txt:excluded", "sub/c.md:excluded"}
+	if !stringsEqual(added, expectedAdded) {
+		t.Fatal(added)
+	}
+	if !stringsEqual(skipped, expectedSkipped) {
+		t.Fatal(skipped)
+	}
+
+	if v := tr.gram[a.FindRune('a')*a.Len()+a.FindRune('b')]; v != float64(len(expectedAdded)) {
+		t.Fatal(v)
+	}
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
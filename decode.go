@@ -0,0 +1,62 @@
+package gibberdet
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+const defaultScratchSize = 8192
+
+// scanRunes reads rdr in chunks into scratch, calling fn for each rune
+// decoded. Runes split across reads are carried over to the next read.
+// Invalid UTF-8 is reported as utf8.RuneError with a size of 1, like
+// utf8.DecodeRune.
+func scanRunes(rdr io.Reader, scratch []byte, fn func(r rune, sz int)) error {
+	if len(scratch) < utf8.UTFMax {
+		scratch = make([]byte, defaultScratchSize)
+	}
+
+	var carry int
+	for {
+		n, err := rdr.Read(scratch[carry:])
+		end := carry + n
+
+		pos := 0
+		for pos < end {
+			if err == nil && !utf8.FullRune(scratch[pos:end]) {
+				break
+			}
+			r, sz := utf8.DecodeRune(scratch[pos:end])
+			fn(r, sz)
+			pos += sz
+		}
+		carry = copy(scratch, scratch[pos:end])
+
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// scanCharset is the same as scanRunes, but decodes each byte using the
+// table for a single-byte encoding instead of decoding UTF-8.
+func scanCharset(rdr io.Reader, scratch []byte, charset *[256]rune, fn func(r rune, sz int)) error {
+	if len(scratch) == 0 {
+		scratch = make([]byte, defaultScratchSize)
+	}
+
+	for {
+		n, err := rdr.Read(scratch)
+		for _, b := range scratch[:n] {
+			fn(charset[b], 1)
+		}
+
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
@@ -23,11 +23,15 @@ Train the model. Use _lots_ of data:
 	trainer := gibberdet.NewTrainer(alpha)
 	err := trainer.Add(strings.NewReader("lots and lots and lots of stuff"))
 	err := trainer.Add(strings.NewReader("even more stuff"))
+	err := trainer.AddPath("corpus.tar.gz", gibberdet.CorpusInclude("*.txt"))
 	model, err := trainer.Compile()
 
-Or use one of the existing built models in `testdata/`, at the moment the
-[OANC](http://www.anc.org/data/oanc/download/) one is probably the best one in
-there.
+Or use one of the pretrained models in the gibberdet/models package, which
+are built from the files in `testdata/`. At the moment the
+[OANC](http://www.anc.org/data/oanc/download/) one is probably the best:
+
+	model := models.EnglishOANC()
+	model.GibberScore("hello") >= models.EnglishOANCThreshold // hopefully 'true'
 
 Save/load the model:
 
@@ -35,6 +39,37 @@ Save/load the model:
 	var load gibberdet.Model
 	err := load.UnmarshalBinary(bts)
 
+	err := model.Save("model.gibber")
+	model, err := gibberdet.Load("model.gibber")
+
+Or compile it into your program, with 'go run tool.go gen' or WriteGoSource:
+
+	err := gibberdet.WriteGoSource(w, model, "mypkg", "Model")
+	model := mypkg.Model()
+
+Models can also be read from, and written for, rrenaud's Python
+Gibberish-Detector:
+
+	model, thresh, err := gibberdet.ReadPythonModel(pkiFile)
+	err := gibberdet.WritePythonJSON(w, model, thresh)
+
+Its scores differ from this package's, as it lowercases its input, drops
+unknown characters rather than penalising them, and uses math.Exp. To get the
+same scores, train and score with CompatPython:
+
+	trainer := gibberdet.NewTrainer(gibberdet.PythonAlphabet,
+		gibberdet.TrainerCompat(gibberdet.CompatPython))
+	model, err := model.WithCompat(gibberdet.CompatPython)
+
+The transitions can be written as CSV or TSV, to inspect or change them in a
+spreadsheet or pandas, and read back, with 'go run tool.go export' and
+'go run tool.go import', or:
+
+	err := gibberdet.WriteMatrix(w, model.Alphabet(), model.Matrix(), ',')
+	err := gibberdet.WriteMatrix(w, alpha, trainer.Counts(), '\t')
+	alpha, rows, err := gibberdet.ReadMatrix(r, ',')
+	model, err := gibberdet.NewModelFromMatrix(alpha, rows)
+
 Build the test threshold with some good and bad strings:
 
 	good := []string{"hello", "world"} // ... and lots more
@@ -0,0 +1,105 @@
+package gibberdet
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Encoding selects how the Trainer and the Model decode their input. See
+// TrainerEncoding and Model.WithEncoding.
+type Encoding int
+
+const (
+	EncodingUTF8 Encoding = iota
+
+	// EncodingLatin1 is ISO-8859-1, in which each byte is the rune with the
+	// same value.
+	EncodingLatin1
+
+	// EncodingWindows1252 is ISO-8859-1 with printable characters in place of
+	// most of the C1 controls (0x80 to 0x9F). The five bytes that are not
+	// defined decode to utf8.RuneError, like invalid UTF-8.
+	EncodingWindows1252
+
+	// EncodingLatin9 is ISO-8859-15, which replaces eight characters of
+	// ISO-8859-1, including the currency sign with the euro sign.
+	EncodingLatin9
+)
+
+var encodingNames = map[Encoding]string{
+	EncodingUTF8:        "utf-8",
+	EncodingLatin1:      "iso-8859-1",
+	EncodingWindows1252: "windows-1252",
+	EncodingLatin9:      "iso-8859-15",
+}
+
+var encodingAliases = map[string]Encoding{
+	"utf8":   EncodingUTF8,
+	"latin1": EncodingLatin1,
+	"cp1252": EncodingWindows1252,
+	"latin9": EncodingLatin9,
+}
+
+// ParseEncoding accepts the names returned by Encoding.String(), and the
+// aliases 'utf8', 'latin1', 'cp1252' and 'latin9'. Names are not case
+// sensitive.
+func ParseEncoding(s string) (Encoding, error) {
+	s = strings.ToLower(s)
+	for k, v := range encodingNames {
+		if v == s {
+			return k, nil
+		}
+	}
+	if e, ok := encodingAliases[s]; ok {
+		return e, nil
+	}
+	return 0, fmt.Errorf("gibberdet: unknown encoding %q", s)
+}
+
+func (e Encoding) String() string {
+	if name, ok := encodingNames[e]; ok {
+		return name
+	}
+	return fmt.Sprintf("Encoding(%d)", int(e))
+}
+
+// charset returns the decode table for a single-byte encoding, or nil for
+// EncodingUTF8.
+func (e Encoding) charset() (*[256]rune, error) {
+	switch e {
+	case EncodingUTF8:
+		return nil, nil
+	case EncodingLatin1:
+		return &charsetLatin1, nil
+	case EncodingWindows1252:
+		return &charsetWindows1252, nil
+	case EncodingLatin9:
+		return &charsetLatin9, nil
+	default:
+		return nil, fmt.Errorf("gibberdet: unknown encoding %d", int(e))
+	}
+}
+
+var charsetLatin1 = func() (cs [256]rune) {
+	for i := range cs {
+		cs[i] = rune(i)
+	}
+	return cs
+}()
+
+var charsetWindows1252 = func() (cs [256]rune) {
+	cs = charsetLatin1
+	copy(cs[0x80:], []rune{
+		'€', utf8.RuneError, '‚', 'ƒ', '„', '…', '†', '‡', 'ˆ', '‰', 'Š', '‹', 'Œ', utf8.RuneError, 'Ž', utf8.RuneError,
+		utf8.RuneError, '‘', '’', '“', '”', '•', '–', '—', '˜', '™', 'š', '›', 'œ', utf8.RuneError, 'ž', 'Ÿ',
+	})
+	return cs
+}()
+
+var charsetLatin9 = func() (cs [256]rune) {
+	cs = charsetLatin1
+	cs[0xA4], cs[0xA6], cs[0xA8], cs[0xB4] = '€', 'Š', 'š', 'Ž'
+	cs[0xB8], cs[0xBC], cs[0xBD], cs[0xBE] = 'ž', 'Œ', 'œ', 'Ÿ'
+	return cs
+}()
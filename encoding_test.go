@@ -0,0 +1,108 @@
+package gibberdet
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func encodeCharset(t *testing.T, e Encoding, s string) string {
+	t.Helper()
+	cs, err := e.charset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []byte
+next:
+	for _, r := range s {
+		for b, c := range cs {
+			if c == r && c != utf8.RuneError {
+				out = append(out, byte(b))
+				continue next
+			}
+		}
+		t.Fatalf("%q not in %s", r, e)
+	}
+	return string(out)
+}
+
+func TestParseEncoding(t *testing.T) {
+	for in, e := range map[string]Encoding{
+		"utf-8":        EncodingUTF8,
+		"UTF8":         EncodingUTF8,
+		"latin1":       EncodingLatin1,
+		"Windows-1252": EncodingWindows1252,
+		"iso-8859-15":  EncodingLatin9,
+	} {
+		out, err := ParseEncoding(in)
+		if err != nil || out != e {
+			t.Fatal(in, out, err)
+		}
+	}
+	if _, err := ParseEncoding("ebcdic"); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestTrainerEncoding(t *testing.T) {
+	const corpus = "déjà vu, the café’s crème brûlée costs €5 — naïve œuvre "
+	alpha := WithOther(AlphabetUnion(ASCIIAlpha, NewAlphabet([]rune("éàèûçï€œ’—"))))
+
+	train := func(e Encoding, in string) *Model {
+		trn := NewTrainer(alpha, TrainerEncoding(e))
+		if err := trn.Add(strings.NewReader(in)); err != nil {
+			t.Fatal(err)
+		}
+		if st := trn.Stats(); st.Invalid != 0 {
+			t.Fatal(e, st.Invalid)
+		}
+		m, err := trn.Compile()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.Encoding() != e {
+			t.Fatal(e, m.Encoding())
+		}
+		return m
+	}
+
+	const e = EncodingWindows1252
+	utf8Model := train(EncodingUTF8, corpus)
+	m := train(e, encodeCharset(t, e, corpus))
+	for from := 0; from < alpha.Len(); from++ {
+		for to := 0; to < alpha.Len(); to++ {
+			if m.table.logProb(from, to) != utf8Model.table.logProb(from, to) {
+				t.Fatal(from, to)
+			}
+		}
+	}
+
+	converted, err := utf8Model.WithEncoding(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	good, bad := encodeCharset(t, e, "crème brûlée"), encodeCharset(t, e, "€œ—’zqxé")
+	if m.GibberScore(good) != converted.GibberScore(good) || m.GibberScore(good) <= m.GibberScore(bad) {
+		t.Fatal(m.GibberScore(good), m.GibberScore(bad))
+	}
+	if m.GibberScore("the cafe") != utf8Model.GibberScore("the cafe") {
+		t.Fatal()
+	}
+
+	back, err := m.WithEncoding(EncodingUTF8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.GibberScore("crème") != utf8Model.GibberScore("crème") {
+		t.Fatal()
+	}
+
+	// Undefined bytes in Windows-1252 are invalid, like invalid UTF-8:
+	trn := NewTrainer(alpha, TrainerEncoding(EncodingWindows1252))
+	if err := trn.Add(strings.NewReader("a\x81b\x9dc")); err != nil {
+		t.Fatal(err)
+	}
+	if st := trn.Stats(); st.Invalid != 2 || st.Runes != 3 {
+		t.Fatal(st)
+	}
+}
@@ -0,0 +1,336 @@
+package gibberdet
+
+import (
+	"bufio"
+	"bytes"
+	"html"
+	"io"
+	"path"
+	"strings"
+)
+
+// InputFilter wraps a reader to clean up its contents before it reaches
+// Trainer.Add.
+type InputFilter func(rdr io.Reader) io.Reader
+
+// StripHTML removes tags, comments and the contents of <script> and <style>
+// elements, and decodes entities. Block-level tags are replaced by a space so
+// that words either side of them are not joined together; inline tags like
+// <b> and <a> are removed entirely.
+func StripHTML(rdr io.Reader) io.Reader {
+	return &markupReader{rdr: rdr, html: true}
+}
+
+// StripXML removes tags, comments and processing instructions, decodes
+// entities and unwraps CDATA sections. Every tag is replaced by a space.
+func StripXML(rdr io.Reader) io.Reader {
+	return &markupReader{rdr: rdr}
+}
+
+// StripMarkdown removes fenced code blocks, inline code spans and link
+// targets, leaving the link text in place.
+func StripMarkdown(rdr io.Reader) io.Reader {
+	return &markdownReader{rdr: bufio.NewReader(rdr)}
+}
+
+// FilterByExt returns StripHTML, StripXML or StripMarkdown based on the
+// extension of name, or nil if there is no filter for it. A trailing '.gz' is
+// ignored.
+func FilterByExt(name string) InputFilter {
+	name = strings.ToLower(name)
+	name = strings.TrimSuffix(name, ".gz")
+	switch path.Ext(name) {
+	case ".html", ".htm", ".xhtml":
+		return StripHTML
+	case ".xml":
+		return StripXML
+	case ".md", ".markdown":
+		return StripMarkdown
+	}
+	return nil
+}
+
+type markupState int
+
+const (
+	markupText markupState = iota
+	markupTagOpen
+	markupTag
+	markupComment
+	markupCDATA
+	markupEntity
+)
+
+const (
+	markupMaxEntity = 32
+	markupMaxName   = 16
+)
+
+// Tags that do not interrupt a word when removed.
+var htmlInlineTags = map[string]bool{
+	"a": true, "abbr": true, "b": true, "bdi": true, "bdo": true, "cite": true,
+	"code": true, "data": true, "dfn": true, "em": true, "i": true, "kbd": true,
+	"mark": true, "q": true, "s": true, "samp": true, "small": true, "span": true,
+	"strong": true, "sub": true, "sup": true, "time": true, "u": true, "var": true,
+	"wbr": true,
+}
+
+type markupReader struct {
+	rdr  io.Reader
+	html bool
+	err  error
+
+	in  [4096]byte
+	out []byte
+
+	state  markupState
+	quote  byte
+	tail   [2]byte // The last two bytes seen in a comment or CDATA section
+	name   []byte
+	entity []byte
+	skip   string // Name of the element whose contents are being skipped
+	space  bool   // Last byte emitted was a space inserted in place of a tag
+}
+
+func (m *markupReader) Read(b []byte) (n int, err error) {
+	for len(m.out) == 0 {
+		if m.err != nil {
+			return 0, m.err
+		}
+
+		var rn int
+		rn, m.err = m.rdr.Read(m.in[:])
+		m.out = m.out[:0]
+		for _, c := range m.in[:rn] {
+			m.next(c)
+		}
+		if m.err == io.EOF && m.state == markupEntity {
+			m.emit(m.entity...)
+			m.state = markupText
+		}
+	}
+
+	n = copy(b, m.out)
+	m.out = m.out[n:]
+	return n, nil
+}
+
+func (m *markupReader) emit(c ...byte) {
+	if m.skip == "" && len(c) > 0 {
+		m.out = append(m.out, c...)
+		m.space = false
+	}
+}
+
+func (m *markupReader) emitSpace() {
+	if m.skip == "" && !m.space {
+		m.out = append(m.out, ' ')
+		m.space = true
+	}
+}
+
+func (m *markupReader) next(c byte) {
+	switch m.state {
+	case markupText:
+		switch c {
+		case '<':
+			m.state = markupTagOpen
+			m.name = m.name[:0]
+		case '&':
+			m.state = markupEntity
+			m.entity = append(m.entity[:0], c)
+		default:
+			m.emit(c)
+		}
+
+	case markupEntity:
+		m.entity = append(m.entity, c)
+		if c == ';' {
+			m.emit([]byte(html.UnescapeString(string(m.entity)))...)
+			m.state = markupText
+		} else if !isEntityByte(c) || len(m.entity) > markupMaxEntity {
+			m.state = markupText
+			m.emit(m.entity[:len(m.entity)-1]...)
+			m.next(c)
+		}
+
+	case markupTagOpen:
+		if len(m.name) == 0 && !isTagStart(c) || m.skip != "" && len(m.name) == 0 && c != '/' {
+			// Not a tag after all, i.e. "a < b":
+			m.state = markupText
+			m.emit('<')
+			m.next(c)
+			return
+		}
+
+		switch c {
+		case '>':
+			m.endTag()
+		case ' ', '\t', '\r', '\n':
+			m.state = markupTag
+		default:
+			if len(m.name) < markupMaxName {
+				m.name = append(m.name, c)
+			}
+			if string(m.name) == "!--" {
+				m.state = markupComment
+				m.tail = [2]byte{}
+			} else if string(m.name) == "![CDATA[" {
+				m.state = markupCDATA
+				m.tail = [2]byte{}
+			}
+		}
+
+	case markupTag:
+		if m.quote != 0 {
+			if c == m.quote {
+				m.quote = 0
+			}
+		} else if c == '"' || c == '\'' {
+			m.quote = c
+		} else if c == '>' {
+			m.endTag()
+		}
+
+	case markupComment:
+		if c == '>' && m.tail == [2]byte{'-', '-'} {
+			m.state = markupText
+		}
+		m.tail[0], m.tail[1] = m.tail[1], c
+
+	case markupCDATA:
+		if c == '>' && m.tail == [2]byte{']', ']'} {
+			m.state = markupText
+			m.emitSpace()
+			return
+		}
+		// Hold back any ']' until we know it isn't part of the terminator:
+		if m.tail[1] == ']' && c != ']' {
+			if m.tail[0] == ']' {
+				m.emit(']')
+			}
+			m.emit(']')
+		}
+		if c != ']' {
+			m.emit(c)
+		} else if m.tail == [2]byte{']', ']'} {
+			m.emit(']')
+		}
+		m.tail[0], m.tail[1] = m.tail[1], c
+	}
+}
+
+func (m *markupReader) endTag() {
+	m.state = markupText
+	m.quote = 0
+
+	name := strings.ToLower(strings.TrimSuffix(string(m.name), "/"))
+	if m.html {
+		if m.skip != "" {
+			if name == "/"+m.skip {
+				m.skip = ""
+			}
+			return
+		}
+		if (name == "script" || name == "style") && !bytes.HasSuffix(m.name, []byte("/")) {
+			m.skip = name
+			return
+		}
+		if htmlInlineTags[strings.TrimPrefix(name, "/")] {
+			return
+		}
+	}
+	m.emitSpace()
+}
+
+func isTagStart(c byte) bool {
+	return c == '/' || c == '!' || c == '?' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isEntityByte(c byte) bool {
+	return c == '#' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+type markdownReader struct {
+	rdr   *bufio.Reader
+	err   error
+	out   []byte
+	line  []byte
+	fence string
+}
+
+func (m *markdownReader) Read(b []byte) (n int, err error) {
+	for len(m.out) == 0 {
+		if m.err != nil {
+			return 0, m.err
+		}
+
+		var line []byte
+		line, m.err = m.rdr.ReadSlice('\n')
+		if m.err == bufio.ErrBufferFull {
+			// Lines longer than the buffer are processed in pieces, which may
+			// split a code span or link; not worth worrying about.
+			m.err = nil
+		}
+		m.line = append(m.line[:0], line...)
+		m.out = m.filterLine(m.line)
+	}
+
+	n = copy(b, m.out)
+	m.out = m.out[n:]
+	return n, nil
+}
+
+func (m *markdownReader) filterLine(line []byte) []byte {
+	trimmed := bytes.TrimLeft(line, " ")
+	if m.fence != "" {
+		if bytes.HasPrefix(trimmed, []byte(m.fence)) {
+			m.fence = ""
+		}
+		return nil
+	}
+	if bytes.HasPrefix(trimmed, []byte("```")) {
+		m.fence = "```"
+		return nil
+	} else if bytes.HasPrefix(trimmed, []byte("~~~")) {
+		m.fence = "~~~"
+		return nil
+	}
+
+	out := line[:0]
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '`':
+			end := bytes.IndexByte(line[i+1:], '`')
+			if end < 0 {
+				out = append(out, line[i:]...)
+				return out
+			}
+			i += end + 1
+
+		case line[i] == '[' && isMarkdownLink(line[i:]):
+			// Only the link text is kept; the ']' and URL are dropped below.
+
+		case line[i] == ']' && i+1 < len(line) && line[i+1] == '(':
+			end := bytes.IndexByte(line[i+2:], ')')
+			if end < 0 {
+				out = append(out, line[i:]...)
+				return out
+			}
+			i += end + 2
+
+		default:
+			out = append(out, line[i])
+		}
+	}
+	return out
+}
+
+// isMarkdownLink reports whether s starts with '[text](url)'.
+func isMarkdownLink(s []byte) bool {
+	end := bytes.IndexByte(s, ']')
+	if end < 0 || end+1 >= len(s) || s[end+1] != '(' {
+		return false
+	}
+	return bytes.IndexByte(s[end+2:], ')') >= 0
+}
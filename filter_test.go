@@ -0,0 +1,42 @@
+package gibberdet
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestStripMarkup(t *testing.T) {
+	for idx, tc := range []struct {
+		filter InputFilter
+		in     string
+		out    string
+	}{
+		{StripHTML, `<p class="a>b">hello</p><p>world</p>`, ` hello world `},
+		{StripHTML, `<b>he</b>llo &amp; w&eacute;rld &bogus`, `hello & wérld &bogus`},
+		{StripHTML, `a < b <!-- <p>nope</p> --> c`, `a < b  c`},
+		{StripHTML, `x<script>if (a<b) { "</p>" }</script>y<style>p{}</style>z`, `xyz`},
+		{StripXML, `<?xml version="1.0"?><a><b>hello</b><![CDATA[x]y]]z]]]></a>`, ` hello x]y]]z] `},
+		{StripMarkdown, "hello `code` [link](http://x)\n```go\nfunc()\n```\nworld\n", "hello  link\nworld\n"},
+		{StripMarkdown, "see [the docs](http://example.com/x) now\n", "see the docs now\n"},
+		{StripMarkdown, "a [b] c [d e\n", "a [b] c [d e\n"},
+	} {
+		out, err := ioutil.ReadAll(tc.filter(strings.NewReader(tc.in)))
+		if err != nil {
+			t.Fatal(idx, err)
+		}
+		if string(out) != tc.out {
+			t.Fatalf("%d: %q != %q", idx, string(out), tc.out)
+		}
+
+		// OneByteReader ensures state carries correctly across reads:
+		out, err = ioutil.ReadAll(tc.filter(iotest.OneByteReader(strings.NewReader(tc.in))))
+		if err != nil {
+			t.Fatal(idx, err)
+		}
+		if string(out) != tc.out {
+			t.Fatalf("%d: %q != %q", idx, string(out), tc.out)
+		}
+	}
+}
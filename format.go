@@ -0,0 +1,147 @@
+package gibberdet
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// Version of the container written by Model.MarshalBinary.
+//
+// Version 1 files are the magic, the length of the body, and the body, which
+// is the alphabet and the table. UnmarshalBinary still reads them.
+//
+// Version 2 files are the magic, formatMarker in place of the length, the
+// version, the metadata, the length of the body, the body, and a SHA-256 of
+// everything before it:
+//
+//	"gibbermodel!"        Magic
+//	uint32 0xFFFFFFFF     formatMarker
+//	uint32 2              Version
+//	uint32 n              Number of metadata entries, sorted by key
+//	  uint32 len, key     ... n times
+//	  uint32 len, value
+//	uint32 len, body      Same as version 1
+//	[32]byte              SHA-256 of all of the above
+//
+// All integers are little endian.
+const FormatVersion = 2
+
+const (
+	formatMagic  = "gibbermodel!"
+	formatMarker = 0xFFFFFFFF
+)
+
+// Errors returned by Model.UnmarshalBinary and Model.ReadFrom. Other problems
+// with the contents of the model are reported with errors that describe them.
+var (
+	ErrBadMagic  = errors.New("gibberdet: model does not start with 'gibbermodel!'")
+	ErrTruncated = errors.New("gibberdet: model truncated")
+	ErrChecksum  = errors.New("gibberdet: model checksum mismatch")
+)
+
+// checkFinite returns an error if v is NaN or infinite. Log probabilities are
+// never infinite, as the Trainer replaces log(0) with a tiny value.
+func checkFinite(what string, v float64) error {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Errorf("gibberdet: %s contains %v", what, v)
+	}
+	return nil
+}
+
+// Well-known keys for Model.Metadata. The Trainer sets MetaPairWeight,
+// MetaAlphabet, MetaEncoding and MetaCreated, and MetaCompat if it is used;
+// use TrainerMetadata to set the others, or any key of your own.
+const (
+	MetaLanguage   = "language"
+	MetaCorpus     = "corpus"
+	MetaPairWeight = "pair_weight"
+	MetaAlphabet   = "alphabet"  // Kind of alphabet; see AlphabetKind
+	MetaEncoding   = "encoding"  // Restored by UnmarshalBinary; see Model.WithEncoding
+	MetaCreated    = "created"   // RFC 3339, UTC
+	MetaThreshold  = "threshold" // Recommended threshold; see ReadPythonModel
+	MetaCompat     = "compat"    // Restored by UnmarshalBinary; see Model.WithCompat
+)
+
+// AlphabetKind returns a short name for the kind of a: 'ascii', 'rune',
+// 'class' if it has rune classes, or 'bytes' for ByteAlphabet. If the
+// alphabet has an OTHER symbol, '+other' is appended.
+func AlphabetKind(a Alphabet) string {
+	var kind string
+	switch a.(type) {
+	case *byteAlphabet:
+		return "bytes"
+	case *asciiAlphabet:
+		kind = "ascii"
+	default:
+		kind = "rune"
+	}
+	if ca, ok := a.(classAlphabet); ok && ca.Classes() != nil {
+		kind = "class"
+	}
+	if a.FindRune(OtherRune) >= 0 {
+		kind += "+other"
+	}
+	return kind
+}
+
+// Metadata returns a copy of the model's metadata. Models read from version 1
+// files have none.
+func (m *Model) Metadata() map[string]string {
+	out := make(map[string]string, len(m.meta))
+	for k, v := range m.meta {
+		out[k] = v
+	}
+	return out
+}
+
+// WithMetadata returns a copy of the model with the entries in meta added to
+// its metadata. Entries with an empty value are removed. The transitions are
+// shared with m.
+func (m *Model) WithMetadata(meta map[string]string) *Model {
+	out := *m
+	out.meta = m.Metadata()
+	for k, v := range meta {
+		if v == "" {
+			delete(out.meta, k)
+		} else {
+			out.meta[k] = v
+		}
+	}
+	return &out
+}
+
+// TrainerMetadata adds entries to the metadata of the models returned by
+// Compile, i.e. TrainerMetadata(MetaLanguage, "en", MetaCorpus, "oanc").
+// It panics if it is not passed pairs of keys and values.
+func TrainerMetadata(kv ...string) TrainerOption {
+	if len(kv)%2 != 0 {
+		panic("gibberdet: TrainerMetadata expects pairs of keys and values")
+	}
+	return func(t *Trainer) {
+		if t.meta == nil {
+			t.meta = make(map[string]string, len(kv)/2)
+		}
+		for i := 0; i < len(kv); i += 2 {
+			t.meta[kv[i]] = kv[i+1]
+		}
+	}
+}
+
+func (t *Trainer) metadata() map[string]string {
+	meta := map[string]string{
+		MetaPairWeight: strconv.FormatFloat(t.pairWeight, 'g', -1, 64),
+		MetaAlphabet:   AlphabetKind(t.alpha),
+		MetaEncoding:   t.encoding.String(),
+		MetaCreated:    time.Now().UTC().Format(time.RFC3339),
+	}
+	if t.compat != CompatNone {
+		meta[MetaCompat] = t.compat.String()
+	}
+	for k, v := range t.meta {
+		meta[k] = v
+	}
+	return meta
+}
@@ -0,0 +1,194 @@
+package gibberdet
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestModelFormatV1(t *testing.T) {
+	for _, name := range []string{"oanc-en.gibber", "gutenberg-en.gibber", "test-cn.gibber"} {
+		b, err := ioutil.ReadFile("testdata/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if binary.LittleEndian.Uint32(b[len(formatMagic):]) == formatMarker {
+			t.Fatal(name, "expected version 1")
+		}
+
+		var v1 Model
+		if err := v1.UnmarshalBinary(b); err != nil {
+			t.Fatal(name, err)
+		}
+		if len(v1.Metadata()) != 0 {
+			t.Fatal(name, v1.Metadata())
+		}
+
+		bts, err := v1.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := binary.LittleEndian.Uint32(bts[len(formatMagic)+4:]); v != FormatVersion {
+			t.Fatal(name, v)
+		}
+		var v2 Model
+		if err := v2.UnmarshalBinary(bts); err != nil {
+			t.Fatal(name, err)
+		}
+		if report := MeasureAccuracy(&v1, &v2, []string{"hello world", "天地玄黃"}); report.MaxAbsError != 0 {
+			t.Fatal(name, report)
+		}
+	}
+}
+
+func TestModelMetadata(t *testing.T) {
+	trn := NewTrainer(WithOther(ASCIIAlpha),
+		TrainerPairWeight(0.5),
+		TrainerEncoding(EncodingLatin1),
+		TrainerMetadata(MetaLanguage, "en", "custom", "yep"))
+	if err := trn.Add(strings.NewReader("the quick brown fox")); err != nil {
+		t.Fatal(err)
+	}
+	m, err := trn.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta := m.Metadata()
+	for k, v := range map[string]string{
+		MetaLanguage:   "en",
+		MetaPairWeight: "0.5",
+		MetaAlphabet:   "ascii+other",
+		MetaEncoding:   "iso-8859-1",
+		"custom":       "yep",
+	} {
+		if meta[k] != v {
+			t.Fatal(k, meta[k])
+		}
+	}
+	if meta[MetaCreated] == "" {
+		t.Fatal()
+	}
+
+	m = m.WithMetadata(map[string]string{MetaCorpus: "test", "custom": ""})
+	if _, ok := m.Metadata()["custom"]; ok || m.Metadata()[MetaCorpus] != "test" {
+		t.Fatal(m.Metadata())
+	}
+
+	bts, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out Model
+	if err := out.UnmarshalBinary(bts); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Metadata()) != len(m.Metadata()) || out.Metadata()[MetaCorpus] != "test" {
+		t.Fatal(out.Metadata())
+	}
+	if out.Encoding() != EncodingLatin1 || out.GibberScore("caf\xe9") != m.GibberScore("caf\xe9") {
+		t.Fatal(out.Encoding())
+	}
+
+	// Any change should fail the checksum:
+	for _, i := range []int{len(formatMagic) + 8, len(bts) / 2, len(bts) - 1} {
+		bad := append([]byte(nil), bts...)
+		bad[i] ^= 1
+		if err := out.UnmarshalBinary(bad); err == nil {
+			t.Fatal(i)
+		}
+	}
+}
+
+// marshalV1 returns m in the version 1 format.
+func marshalV1(t testing.TB, m *Model) []byte {
+	body, err := m.marshalBody()
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := append([]byte(formatMagic), 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(out[len(formatMagic):], uint32(len(body)))
+	return append(out, body...)
+}
+
+func TestModelUnmarshalErrors(t *testing.T) {
+	m := loadTestModel(t, "test-cn.gibber")
+
+	var models [][]byte
+	for _, cm := range convertTestModel(t, m) {
+		v2, err := cm.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		models = append(models, marshalV1(t, cm), v2)
+	}
+
+	for idx, bts := range models {
+		var out Model
+		if err := out.UnmarshalBinary(bts); err != nil {
+			t.Fatal(idx, err)
+		}
+
+		// Every truncation of a version 2 file past the header fails the
+		// checksum, so only some of them are checked:
+		v2 := binary.LittleEndian.Uint32(bts[len(formatMagic):]) == formatMarker
+		for i := 0; i < len(bts); i++ {
+			if v2 && i > 64 && i+97 < len(bts) {
+				i += 96
+			}
+			err := out.UnmarshalBinary(bts[:i])
+			switch {
+			case i < len(formatMagic):
+				if !errors.Is(err, ErrBadMagic) {
+					t.Fatal(idx, i, err)
+				}
+			case v2 && i >= len(formatMagic)+4+sha256.Size:
+				if !errors.Is(err, ErrChecksum) {
+					t.Fatal(idx, i, err)
+				}
+			default:
+				if !errors.Is(err, ErrTruncated) {
+					t.Fatal(idx, i, err)
+				}
+			}
+		}
+	}
+
+	var out Model
+	if err := out.UnmarshalBinary([]byte("GIBBERMODEL!\x00\x00\x00\x00")); !errors.Is(err, ErrBadMagic) {
+		t.Fatal(err)
+	}
+
+	// The dense table in a version 1 file starts after the alphabet and the
+	// gram size:
+	dense := marshalV1(t, m)
+	alphaSz := int(binary.LittleEndian.Uint32(dense[len(formatMagic)+4:]))
+	gramPos := len(formatMagic) + 4 + 4 + alphaSz
+
+	bad := append([]byte(nil), dense...)
+	binary.LittleEndian.PutUint64(bad[gramPos+4+80:], math.Float64bits(math.NaN()))
+	if err := out.UnmarshalBinary(bad); err == nil || !strings.Contains(err.Error(), "NaN") {
+		t.Fatal(err)
+	}
+
+	bad = append([]byte(nil), dense...)
+	binary.LittleEndian.PutUint64(bad[gramPos+4:], math.Float64bits(math.Inf(-1)))
+	if err := out.UnmarshalBinary(bad); err == nil {
+		t.Fatal()
+	}
+
+	// Drop the last row and column, keeping the sizes consistent:
+	n := m.alpha.Len()
+	bad = append([]byte(nil), dense[:gramPos]...)
+	bad = append(bad, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(bad[gramPos:], uint32((n-1)*(n-1)))
+	bad = append(bad, make([]byte, (n-1)*(n-1)*8)...)
+	binary.LittleEndian.PutUint32(bad[len(formatMagic):], uint32(len(bad)-len(formatMagic)-4))
+	if err := out.UnmarshalBinary(bad); err == nil || !strings.Contains(err.Error(), "squared") {
+		t.Fatal(err)
+	}
+}
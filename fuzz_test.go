@@ -0,0 +1,83 @@
+//go:build go1.18
+// +build go1.18
+
+package gibberdet
+
+import (
+	"strings"
+	"testing"
+)
+
+func fuzzSeeds(f *testing.F) (seeds [][]byte) {
+	trn := NewTrainer(NewClassAlphabet(append(FoldCase([]rune("ab")), []rune{'天', OtherRune})...))
+	if err := trn.Add(strings.NewReader("abba 天 ab")); err != nil {
+		f.Fatal(err)
+	}
+	m, err := trn.Compile()
+	if err != nil {
+		f.Fatal(err)
+	}
+	for _, cm := range convertTestModel(f, m) {
+		v2, err := cm.MarshalBinary()
+		if err != nil {
+			f.Fatal(err)
+		}
+		seeds = append(seeds, marshalV1(f, cm), v2)
+	}
+	return seeds
+}
+
+func fuzzModel(t *testing.T, m *Model, data []byte) {
+	m.GibberScore("hello world")
+	m.GibberScore("天地玄黃 abc")
+	m.GibberScoreBytes(data)
+	if _, err := m.MarshalBinary(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func FuzzUnmarshalBinary(f *testing.F) {
+	for _, seed := range fuzzSeeds(f) {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var m Model
+		err := m.UnmarshalBinary(data)
+
+		// NewModelFromBytes must behave the same way, at any alignment:
+		for _, shifted := range shiftedCopies(data)[:2] {
+			nc, ncErr := NewModelFromBytes(shifted)
+			if (err == nil) != (ncErr == nil) || (err != nil && err.Error() != ncErr.Error()) {
+				t.Fatal(err, ncErr)
+			}
+			if ncErr == nil {
+				fuzzModel(t, nc, data)
+			}
+		}
+		if err != nil {
+			return
+		}
+		fuzzModel(t, &m, data)
+	})
+}
+
+func FuzzUnmarshalText(f *testing.F) {
+	for _, seed := range fuzzSeeds(f) {
+		var m Model
+		if err := m.UnmarshalBinary(seed); err != nil {
+			f.Fatal(err)
+		}
+		text, err := m.MarshalText()
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(text)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var m Model
+		if err := m.UnmarshalText(data); err != nil {
+			return
+		}
+		fuzzModel(t, &m, data)
+	})
+}
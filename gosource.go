@@ -0,0 +1,301 @@
+package gibberdet
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"sort"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ModelData holds a model's alphabet, table and metadata as plain values. It
+// is used by the Go source written by WriteGoSource, so that a model can be
+// compiled into a program without decoding it at runtime.
+//
+// Which of the table fields are used depends on Storage:
+//
+//	StorageDense:   Gram, Len()*Len() log probabilities
+//	StorageFloat32: Gram32, same as StorageDense
+//	StorageSparse:  Defaults, Rows, Cols and Vals, in compressed sparse row
+//	                form: the columns and log probabilities for row i are at
+//	                Cols[Rows[i]:Rows[i+1]] and Vals[Rows[i]:Rows[i+1]]
+//	StorageQuant16: Min, Scale and Quant16; each log probability is
+//	                Min[row] + value*Scale[row]
+//	StorageQuant8:  Min, Scale and Quant8, same as StorageQuant16
+type ModelData struct {
+	Alphabet string // Spec; see AlphabetSpec
+	Bytes    bool   // ByteAlphabet; Alphabet is ignored
+	Storage  Storage
+	Metadata map[string]string
+
+	Gram   []float64
+	Gram32 []float32
+
+	Defaults []float64
+	Rows     []uint32
+	Cols     []uint32
+	Vals     []float64
+
+	Min     []float64
+	Scale   []float64
+	Quant16 []uint16
+	Quant8  []uint8
+}
+
+// Data returns the model's alphabet, table and metadata. The slices are
+// shared with m and must not be modified.
+func (m *Model) Data() *ModelData {
+	d := &ModelData{
+		Alphabet: AlphabetSpec(m.alpha),
+		Storage:  m.Storage(),
+		Metadata: m.Metadata(),
+	}
+	if _, ok := m.alpha.(*byteAlphabet); ok {
+		d.Alphabet, d.Bytes = "", true
+	}
+
+	switch table := m.table.(type) {
+	case *denseTable:
+		d.Gram = table.gram
+	case *float32Table:
+		d.Gram32 = table.gram
+	case *sparseTable:
+		d.Defaults, d.Rows, d.Cols, d.Vals = table.defaults, table.rows, table.cols, table.vals
+	case *quant16Table:
+		d.Min, d.Scale, d.Quant16 = table.min, table.scale, table.gram
+	case *quant8Table:
+		d.Min, d.Scale, d.Quant8 = table.min, table.scale, table.gram
+	}
+	return d
+}
+
+// NewModelFromData returns a model using the values in d, which are not
+// copied and must not be modified afterwards. Like UnmarshalBinary, it checks
+// that the table matches the alphabet, and rejects NaN or infinite log
+// probabilities.
+func NewModelFromData(d *ModelData) (*Model, error) {
+	var alpha Alphabet = ByteAlphabet
+	if !d.Bytes {
+		var err error
+		if alpha, err = ParseAlphabetSpec(d.Alphabet); err != nil {
+			return nil, err
+		}
+	}
+	n := alpha.Len()
+	nn := n * n
+
+	checkLen := func(what string, got, want int) error {
+		if got != want {
+			return fmt.Errorf("gibberdet: expected %d values in %s, found %d", want, what, got)
+		}
+		return nil
+	}
+	checkValues := func(what string, vals []float64) error {
+		for _, v := range vals {
+			if err := checkFinite(what, v); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var table gramTable
+	switch d.Storage {
+	case StorageDense:
+		if err := checkLen("Gram", len(d.Gram), nn); err != nil {
+			return nil, err
+		}
+		if err := checkValues("Gram", d.Gram); err != nil {
+			return nil, err
+		}
+		table = &denseTable{gram: d.Gram, n: n}
+
+	case StorageFloat32:
+		if err := checkLen("Gram32", len(d.Gram32), nn); err != nil {
+			return nil, err
+		}
+		for _, v := range d.Gram32 {
+			if err := checkFinite("Gram32", float64(v)); err != nil {
+				return nil, err
+			}
+		}
+		table = &float32Table{gram: d.Gram32, n: n}
+
+	case StorageSparse:
+		st := &sparseTable{n: n, defaults: d.Defaults, rows: d.Rows, cols: d.Cols, vals: d.Vals}
+		if err := st.check(); err != nil {
+			return nil, err
+		}
+		if err := checkValues("Defaults", d.Defaults); err != nil {
+			return nil, err
+		}
+		if err := checkValues("Vals", d.Vals); err != nil {
+			return nil, err
+		}
+		table = st
+
+	case StorageQuant16, StorageQuant8:
+		if err := checkLen("Min", len(d.Min), n); err != nil {
+			return nil, err
+		}
+		if err := checkLen("Scale", len(d.Scale), n); err != nil {
+			return nil, err
+		}
+		if err := checkValues("Min", d.Min); err != nil {
+			return nil, err
+		}
+		if err := checkValues("Scale", d.Scale); err != nil {
+			return nil, err
+		}
+		qr := quantRows{n: n, min: d.Min, scale: d.Scale}
+		if d.Storage == StorageQuant16 {
+			if err := checkLen("Quant16", len(d.Quant16), nn); err != nil {
+				return nil, err
+			}
+			table = &quant16Table{quantRows: qr, gram: d.Quant16}
+		} else {
+			if err := checkLen("Quant8", len(d.Quant8), nn); err != nil {
+				return nil, err
+			}
+			table = &quant8Table{quantRows: qr, gram: d.Quant8}
+		}
+
+	default:
+		return nil, fmt.Errorf("gibberdet: unsupported storage %s", d.Storage)
+	}
+
+	var meta map[string]string
+	if len(d.Metadata) > 0 {
+		meta = d.Metadata
+	}
+
+	m := &Model{}
+	if err := m.decoded(alpha, table, meta); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WriteGoSource writes a Go source file for package pkg, which declares an
+// exported function called name that returns m. The model's data is declared
+// as literals, so it is compiled into the program; the function only checks
+// it and builds the Model, on the first call.
+func WriteGoSource(w io.Writer, m *Model, pkg, name string) error {
+	if !token.IsIdentifier(pkg) {
+		return fmt.Errorf("gibberdet: invalid package name %q", pkg)
+	}
+	first, sz := utf8.DecodeRuneInString(name)
+	if !token.IsIdentifier(name) || !unicode.IsUpper(first) {
+		return fmt.Errorf("gibberdet: invalid exported name %q", name)
+	}
+	unexported := string(unicode.ToLower(first)) + name[sz:]
+
+	d := m.Data()
+	var buf bytes.Buffer
+	p := func(format string, args ...interface{}) { fmt.Fprintf(&buf, format, args...) }
+
+	p("// Code generated by gibberdet.WriteGoSource; DO NOT EDIT.\n\n")
+	p("package %s\n\n", pkg)
+	p("import (\n\t\"sync\"\n\n\t\"github.com/shabbyrobe/gibberdet\"\n)\n\n")
+
+	p("var (\n\t%sOnce  sync.Once\n\t%sModel *gibberdet.Model\n)\n\n", unexported, unexported)
+	p("// %s returns the compiled-in model. It is built on the first call, and\n", name)
+	p("// is safe for concurrent use.\n")
+	p("func %s() *gibberdet.Model {\n", name)
+	p("\t%sOnce.Do(func() {\n", unexported)
+	p("\t\tm, err := gibberdet.NewModelFromData(&%sData)\n", unexported)
+	p("\t\tif err != nil {\n\t\t\tpanic(err)\n\t\t}\n")
+	p("\t\t%sModel = m\n", unexported)
+	p("\t})\n\treturn %sModel\n}\n\n", unexported)
+
+	p("var %sData = gibberdet.ModelData{\n", unexported)
+	if d.Bytes {
+		p("Bytes: true,\n")
+	} else {
+		p("Alphabet: %s,\n", strconv.Quote(d.Alphabet))
+	}
+	p("Storage: gibberdet.Storage%s,\n", storageIdents[d.Storage])
+
+	if len(d.Metadata) > 0 {
+		keys := make([]string, 0, len(d.Metadata))
+		for k := range d.Metadata {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		p("Metadata: map[string]string{\n")
+		for _, k := range keys {
+			p("%s: %s,\n", strconv.Quote(k), strconv.Quote(d.Metadata[k]))
+		}
+		p("},\n")
+	}
+
+	floats := func(field string, vals []float64) {
+		writeGoSlice(&buf, field, "float64", len(vals), 8, func(i int) string {
+			return strconv.FormatFloat(vals[i], 'g', -1, 64)
+		})
+	}
+	uints := func(field, typ string, count, perLine int, val func(i int) uint64) {
+		writeGoSlice(&buf, field, typ, count, perLine, func(i int) string {
+			return strconv.FormatUint(val(i), 10)
+		})
+	}
+
+	switch d.Storage {
+	case StorageDense:
+		floats("Gram", d.Gram)
+	case StorageFloat32:
+		writeGoSlice(&buf, "Gram32", "float32", len(d.Gram32), 8, func(i int) string {
+			return strconv.FormatFloat(float64(d.Gram32[i]), 'g', -1, 32)
+		})
+	case StorageSparse:
+		floats("Defaults", d.Defaults)
+		uints("Rows", "uint32", len(d.Rows), 16, func(i int) uint64 { return uint64(d.Rows[i]) })
+		uints("Cols", "uint32", len(d.Cols), 16, func(i int) uint64 { return uint64(d.Cols[i]) })
+		floats("Vals", d.Vals)
+	case StorageQuant16:
+		floats("Min", d.Min)
+		floats("Scale", d.Scale)
+		uints("Quant16", "uint16", len(d.Quant16), 16, func(i int) uint64 { return uint64(d.Quant16[i]) })
+	case StorageQuant8:
+		floats("Min", d.Min)
+		floats("Scale", d.Scale)
+		uints("Quant8", "uint8", len(d.Quant8), 16, func(i int) uint64 { return uint64(d.Quant8[i]) })
+	}
+	p("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(src)
+	return err
+}
+
+var storageIdents = map[Storage]string{
+	StorageDense:   "Dense",
+	StorageSparse:  "Sparse",
+	StorageFloat32: "Float32",
+	StorageQuant16: "Quant16",
+	StorageQuant8:  "Quant8",
+}
+
+func writeGoSlice(buf *bytes.Buffer, field, typ string, count, perLine int, val func(i int) string) {
+	fmt.Fprintf(buf, "%s: []%s{", field, typ)
+	for i := 0; i < count; i++ {
+		if i%perLine == 0 {
+			buf.WriteString("\n")
+		} else {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(val(i))
+		buf.WriteString(",")
+	}
+	if count > 0 {
+		buf.WriteString("\n")
+	}
+	buf.WriteString("},\n")
+}
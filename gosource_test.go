@@ -0,0 +1,168 @@
+package gibberdet
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func goSourceTestModels(t *testing.T) map[string]*Model {
+	t.Helper()
+	m := loadTestModel(t, "test-cn.gibber").WithMetadata(map[string]string{MetaLanguage: "zh"})
+	models := map[string]*Model{}
+	for _, cm := range convertTestModel(t, m) {
+		s := cm.Storage()
+		models["CN"+storageIdents[s]] = cm
+	}
+
+	tr := NewTrainer(WithOther(ASCIIAlphaFolded))
+	if err := tr.Add(strings.NewReader("Hello world, the quick brown fox 123")); err != nil {
+		t.Fatal(err)
+	}
+	other, err := tr.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	models["Other"] = other
+
+	tr = NewTrainer(ByteAlphabet)
+	if err := tr.Add(strings.NewReader("hello \xff\x00 world")); err != nil {
+		t.Fatal(err)
+	}
+	bytesModel, err := tr.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	models["Bytes"] = bytesModel
+	return models
+}
+
+func TestModelData(t *testing.T) {
+	for name, m := range goSourceTestModels(t) {
+		out, err := NewModelFromData(m.Data())
+		if err != nil {
+			t.Fatal(name, err)
+		}
+		want, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := out.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(want, got) {
+			t.Fatal(name, "does not match")
+		}
+	}
+
+	m := loadTestModel(t, "oanc-en.gibber")
+	d := *m.Data()
+	d.Gram = d.Gram[1:]
+	if _, err := NewModelFromData(&d); err == nil {
+		t.Fatal()
+	}
+
+	sparse, err := m.Convert(StorageSparse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d = *sparse.Data()
+	d.Cols = append([]uint32(nil), d.Cols...)
+	d.Cols[0] = uint32(m.Alphabet().Len())
+	if _, err := NewModelFromData(&d); err == nil || !strings.Contains(err.Error(), "out of range") {
+		t.Fatal(err)
+	}
+}
+
+func TestWriteGoSource(t *testing.T) {
+	m := loadTestModel(t, "oanc-en.gibber")
+	for _, name := range []string{"", "model", "1Model", "Mo del"} {
+		if err := WriteGoSource(ioutil.Discard, m, "models", name); err == nil {
+			t.Fatal(name)
+		}
+	}
+	if err := WriteGoSource(ioutil.Discard, m, "my-models", "Model"); err == nil {
+		t.Fatal()
+	}
+
+	var buf bytes.Buffer
+	if err := WriteGoSource(&buf, m, "models", "EnglishOANC"); err != nil {
+		t.Fatal(err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "", buf.Bytes(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Name.Name != "models" || f.Scope.Lookup("EnglishOANC") == nil {
+		t.Fatal()
+	}
+}
+
+// TestWriteGoSourceCompile builds a program using the generated source, and
+// checks that each compiled-in model encodes the same as the original.
+func TestWriteGoSourceCompile(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	// The program must be inside the module to import it:
+	dir, err := ioutil.TempDir("testdata", "gosource")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	models := goSourceTestModels(t)
+	var main bytes.Buffer
+	var want bytes.Buffer
+	main.WriteString("package main\n\nimport (\n\t\"crypto/sha256\"\n\t\"fmt\"\n)\n\nfunc main() {\n")
+	for name, m := range models {
+		var src bytes.Buffer
+		if err := WriteGoSource(&src, m, "main", name); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, strings.ToLower(name)+".go"), src.Bytes(), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		bts, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprintf(&want, "%s %x\n", name, sha256.Sum256(bts))
+		fmt.Fprintf(&main, "\tif bts, err := %s().MarshalBinary(); err != nil {\n\t\tpanic(err)\n\t} else {\n", name)
+		fmt.Fprintf(&main, "\t\tfmt.Printf(\"%s %%x\\n\", sha256.Sum256(bts))\n\t}\n", name)
+	}
+	main.WriteString("}\n")
+	if err := ioutil.WriteFile(filepath.Join(dir, "main.go"), main.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(goBin, "run", "./"+filepath.ToSlash(dir))
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatal(err, string(out))
+	}
+
+	wantLines := strings.Split(strings.TrimSpace(want.String()), "\n")
+	gotLines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	sort.Strings(wantLines)
+	sort.Strings(gotLines)
+	if strings.Join(wantLines, "\n") != strings.Join(gotLines, "\n") {
+		t.Fatalf("want:\n%s\ngot:\n%s", want.String(), out)
+	}
+}
@@ -0,0 +1,324 @@
+package gibberdet
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Version of the layout written by Model.MarshalJSON.
+const jsonVersion = 1
+
+// modelJSON is the layout used by Model.MarshalJSON. Which of the table fields
+// are used depends on the storage:
+//
+//	dense:          transitions is an array of rows of log probabilities
+//	float32:        same as dense, with float32 precision
+//	sparse:         defaults has each row's default, entries has the rest
+//	quant16/quant8: transitions is an array of rows of quantized values, and
+//	                each row's log probability is min + value*scale
+type modelJSON struct {
+	Version  int               `json:"version"`
+	Alphabet string            `json:"alphabet"`
+	Bytes    bool              `json:"bytes,omitempty"` // ByteAlphabet
+	Storage  string            `json:"storage"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	Transitions json.RawMessage `json:"transitions,omitempty"`
+	Defaults    []float64       `json:"defaults,omitempty"`
+	Entries     []entryJSON     `json:"entries,omitempty"`
+	Min         []float64       `json:"min,omitempty"`
+	Scale       []float64       `json:"scale,omitempty"`
+}
+
+// entryJSON is a transition in a sparse table. From and To are written the
+// same way as a rune in an alphabet spec, so OTHER is `\o`.
+type entryJSON struct {
+	From string  `json:"from"`
+	To   string  `json:"to"`
+	LogP float64 `json:"logp"`
+}
+
+// MarshalJSON writes the model in a readable layout, with the alphabet as a
+// spec (see AlphabetSpec), the transitions as arrays of rows (or a list of
+// entries for StorageSparse), and the metadata. It contains exactly the same
+// information as MarshalBinary, and either can be converted to the other
+// without loss.
+func (m *Model) MarshalJSON() ([]byte, error) {
+	out := modelJSON{
+		Version:  jsonVersion,
+		Alphabet: AlphabetSpec(m.alpha),
+		Storage:  m.Storage().String(),
+		Metadata: m.meta,
+	}
+	if _, ok := m.alpha.(*byteAlphabet); ok {
+		out.Bytes = true
+	}
+
+	var err error
+	switch table := m.table.(type) {
+	case *denseTable:
+		rows := make([][]float64, table.n)
+		for i := range rows {
+			rows[i] = table.gram[i*table.n : i*table.n+table.n]
+		}
+		out.Transitions, err = json.Marshal(rows)
+
+	case *float32Table:
+		rows := make([][]float32, table.n)
+		for i := range rows {
+			rows[i] = table.gram[i*table.n : i*table.n+table.n]
+		}
+		out.Transitions, err = json.Marshal(rows)
+
+	case *quant16Table:
+		out.Min, out.Scale = table.min, table.scale
+		rows := make([][]uint16, table.n)
+		for i := range rows {
+			rows[i] = table.gram[i*table.n : i*table.n+table.n]
+		}
+		out.Transitions, err = json.Marshal(rows)
+
+	case *quant8Table:
+		// []uint8 would be written as base64, so the values are widened:
+		out.Min, out.Scale = table.min, table.scale
+		rows := make([][]uint16, table.n)
+		for i := range rows {
+			rows[i] = make([]uint16, table.n)
+			for j := range rows[i] {
+				rows[i][j] = uint16(table.gram[i*table.n+j])
+			}
+		}
+		out.Transitions, err = json.Marshal(rows)
+
+	case *sparseTable:
+		runes := m.alpha.Runes()
+		out.Defaults = table.defaults
+		out.Entries = make([]entryJSON, 0, len(table.cols))
+		for from := 0; from < table.n; from++ {
+			for i := table.rows[from]; i < table.rows[from+1]; i++ {
+				out.Entries = append(out.Entries, entryJSON{
+					From: specRune(runes[from]),
+					To:   specRune(runes[table.cols[i]]),
+					LogP: table.vals[i],
+				})
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("gibberdet: unsupported table %T", m.table)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&out)
+}
+
+// UnmarshalJSON reads a model written by MarshalJSON. Like UnmarshalBinary,
+// it checks that the table matches the alphabet, and rejects NaN or infinite
+// log probabilities.
+func (m *Model) UnmarshalJSON(data []byte) (err error) {
+	var in modelJSON
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	if in.Version != jsonVersion {
+		return fmt.Errorf("gibberdet: unsupported JSON model version %d", in.Version)
+	}
+
+	var alpha Alphabet
+	if in.Bytes {
+		alpha = ByteAlphabet
+	} else if alpha, err = ParseAlphabetSpec(in.Alphabet); err != nil {
+		return err
+	}
+	n := alpha.Len()
+
+	storage, err := ParseStorage(in.Storage)
+	if err != nil {
+		return err
+	}
+
+	var table gramTable
+	switch storage {
+	case StorageDense:
+		var rows [][]float64
+		if err := json.Unmarshal(in.Transitions, &rows); err != nil {
+			return err
+		}
+		if err := checkJSONRows(n, len(rows), func(i int) int { return len(rows[i]) }); err != nil {
+			return err
+		}
+		gram := make([]float64, 0, n*n)
+		for _, row := range rows {
+			for _, v := range row {
+				if err := checkFinite("transitions", v); err != nil {
+					return err
+				}
+			}
+			gram = append(gram, row...)
+		}
+		table = &denseTable{gram: gram, n: n}
+
+	case StorageFloat32:
+		var rows [][]float32
+		if err := json.Unmarshal(in.Transitions, &rows); err != nil {
+			return err
+		}
+		if err := checkJSONRows(n, len(rows), func(i int) int { return len(rows[i]) }); err != nil {
+			return err
+		}
+		gram := make([]float32, 0, n*n)
+		for _, row := range rows {
+			gram = append(gram, row...)
+		}
+		table = &float32Table{gram: gram, n: n}
+
+	case StorageQuant16, StorageQuant8:
+		if len(in.Min) != n || len(in.Scale) != n {
+			return fmt.Errorf("gibberdet: expected %d quantized rows", n)
+		}
+		for i := 0; i < n; i++ {
+			if err := checkFinite("min", in.Min[i]); err != nil {
+				return err
+			}
+			if err := checkFinite("scale", in.Scale[i]); err != nil {
+				return err
+			}
+		}
+		var rows [][]uint16
+		if err := json.Unmarshal(in.Transitions, &rows); err != nil {
+			return err
+		}
+		if err := checkJSONRows(n, len(rows), func(i int) int { return len(rows[i]) }); err != nil {
+			return err
+		}
+		gram := make([]uint16, 0, n*n)
+		for _, row := range rows {
+			gram = append(gram, row...)
+		}
+
+		qr := quantRows{n: n, min: in.Min, scale: in.Scale}
+		if storage == StorageQuant16 {
+			table = &quant16Table{quantRows: qr, gram: gram}
+		} else {
+			qt := &quant8Table{quantRows: qr, gram: make([]uint8, len(gram))}
+			for i, v := range gram {
+				if v > 0xFF {
+					return fmt.Errorf("gibberdet: quantized value %d out of range", v)
+				}
+				qt.gram[i] = uint8(v)
+			}
+			table = qt
+		}
+
+	case StorageSparse:
+		if table, err = sparseFromJSON(alpha, in.Defaults, in.Entries); err != nil {
+			return err
+		}
+
+	default:
+		return fmt.Errorf("gibberdet: unsupported storage %s", storage)
+	}
+
+	return m.decoded(alpha, table, in.Metadata)
+}
+
+func sparseFromJSON(alpha Alphabet, defaults []float64, entries []entryJSON) (*sparseTable, error) {
+	n := alpha.Len()
+	if len(defaults) != n {
+		return nil, fmt.Errorf("gibberdet: expected %d defaults, found %d", n, len(defaults))
+	}
+	for _, v := range defaults {
+		if err := checkFinite("defaults", v); err != nil {
+			return nil, err
+		}
+	}
+
+	type entry struct {
+		from, to uint32
+		val      float64
+	}
+	parsed := make([]entry, len(entries))
+	for i, e := range entries {
+		from, err := findSpecRune(alpha, e.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := findSpecRune(alpha, e.To)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkFinite("entries", e.LogP); err != nil {
+			return nil, err
+		}
+		parsed[i] = entry{from: uint32(from), to: uint32(to), val: e.LogP}
+	}
+	sort.Slice(parsed, func(i, j int) bool {
+		if parsed[i].from != parsed[j].from {
+			return parsed[i].from < parsed[j].from
+		}
+		return parsed[i].to < parsed[j].to
+	})
+
+	st := &sparseTable{
+		n:        n,
+		defaults: defaults,
+		rows:     make([]uint32, n+1),
+		cols:     make([]uint32, len(parsed)),
+		vals:     make([]float64, len(parsed)),
+	}
+	for i, e := range parsed {
+		if i > 0 && e.from == parsed[i-1].from && e.to == parsed[i-1].to {
+			runes := alpha.Runes()
+			return nil, fmt.Errorf("gibberdet: duplicate entry from %q to %q", specRune(runes[e.from]), specRune(runes[e.to]))
+		}
+		st.cols[i], st.vals[i] = e.to, e.val
+		st.rows[e.from+1] = uint32(i + 1)
+	}
+	for i := 1; i <= n; i++ {
+		if st.rows[i] < st.rows[i-1] {
+			st.rows[i] = st.rows[i-1]
+		}
+	}
+	return st, nil
+}
+
+// checkJSONRows checks that there are n rows of n values.
+func checkJSONRows(n int, rows int, rowLen func(i int) int) error {
+	if rows != n {
+		return fmt.Errorf("gibberdet: expected %d rows of transitions, found %d", n, rows)
+	}
+	for i := 0; i < rows; i++ {
+		if rowLen(i) != n {
+			return fmt.Errorf("gibberdet: expected %d transitions in row %d, found %d", n, i, rowLen(i))
+		}
+	}
+	return nil
+}
+
+// specRune returns rn as it would be written in an alphabet spec.
+func specRune(rn rune) string {
+	var sb strings.Builder
+	writeSpecRune(&sb, rn)
+	return sb.String()
+}
+
+// findSpecRune returns the position in alpha of a single rune written in the
+// same way as an alphabet spec.
+func findSpecRune(alpha Alphabet, s string) (int, error) {
+	items, err := parseAlphabetSpec(s)
+	if err != nil {
+		return -1, err
+	}
+	if len(items) != 1 || items[0].class || len(items[0].runes) != 1 {
+		return -1, fmt.Errorf("gibberdet: expected a single rune, found %q", s)
+	}
+	pos := alpha.FindRune(items[0].runes[0])
+	if pos < 0 {
+		return -1, fmt.Errorf("gibberdet: rune %q is not in the alphabet", s)
+	}
+	return pos, nil
+}
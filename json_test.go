@@ -0,0 +1,97 @@
+package gibberdet
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestModelJSONRoundTrip(t *testing.T) {
+	var models []*Model
+	for _, name := range []string{"oanc-en.gibber", "test-cn.gibber"} {
+		models = append(models, loadTestModel(t, name))
+	}
+	for _, alpha := range []Alphabet{
+		WithOther(NewClassAlphabet(append(FoldCase([]rune("abc")), DigitClass, []rune("-[]\\ "))...)),
+		ByteAlphabet,
+	} {
+		trn := NewTrainer(alpha, TrainerEncoding(EncodingWindows1252), TrainerMetadata(MetaLanguage, "xx"))
+		if err := trn.Add(strings.NewReader("Abc-12 [cab]\\ Zz\x80\xff")); err != nil {
+			t.Fatal(err)
+		}
+		m, err := trn.Compile()
+		if err != nil {
+			t.Fatal(err)
+		}
+		models = append(models, m)
+	}
+
+	for idx, ref := range models {
+		for _, m := range convertTestModel(t, ref) {
+			s := m.Storage()
+			js, err := json.Marshal(m)
+			if err != nil {
+				t.Fatal(idx, s, err)
+			}
+			var out Model
+			if err := json.Unmarshal(js, &out); err != nil {
+				t.Fatal(idx, s, err)
+			}
+
+			want, err := m.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := out.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(want, got) {
+				t.Fatal(idx, s, "binary encoding differs after JSON round trip")
+			}
+			if out.Encoding() != m.Encoding() {
+				t.Fatal(idx, s, out.Encoding())
+			}
+		}
+	}
+}
+
+func TestModelJSONLayout(t *testing.T) {
+	trn := NewTrainer(NewAlphabet([]rune("ab-")), TrainerStorage(StorageSparse))
+	if err := trn.Add(strings.NewReader("ab-ab")); err != nil {
+		t.Fatal(err)
+	}
+	m, err := trn.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	js, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var raw map[string]interface{}
+	if err := json.Unmarshal(js, &raw); err != nil {
+		t.Fatal(err)
+	}
+	if raw["alphabet"] != `ab\-` || raw["storage"] != "sparse" || len(raw["defaults"].([]interface{})) != 3 {
+		t.Fatal(string(js))
+	}
+	if !strings.Contains(string(js), `{"from":"b","to":"\\-","logp":`) {
+		t.Fatal(string(js))
+	}
+
+	var out Model
+	for idx, bad := range []string{
+		strings.Replace(string(js), `"to":"\\-"`, `"to":"z"`, 1),
+		strings.Replace(string(js), `"from":"a","to":"b"`, `"from":"b","to":"\\-"`, 1),
+		strings.Replace(string(js), `"version":1`, `"version":9`, 1),
+		`{"version":1,"alphabet":"ab","storage":"dense","transitions":[[0,0],[0]]}`,
+		`{"version":1,"alphabet":"ab","storage":"quant8","min":[0,0],"scale":[0,0],"transitions":[[0,0],[0,256]]}`,
+	} {
+		if err := json.Unmarshal([]byte(bad), &out); err == nil {
+			t.Fatal(idx, bad)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package gibberdet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// matrixTolerance is how far the probabilities in each row passed to
+// NewModelFromMatrix may sum to from 1. It allows for StorageFloat32, but not
+// for the quantized storage; see Model.Matrix.
+const matrixTolerance = 1e-6
+
+// Matrix returns the model's transitions as log probabilities: rows[i][j] is
+// the log probability of the symbol at position j in the alphabet following
+// the symbol at position i. Transitions that were never seen are -Inf, a
+// probability of 0.
+//
+// Models that use StorageQuant16 or StorageQuant8 may lose too much precision
+// for their rows to be passed back to NewModelFromMatrix without normalising
+// them again.
+func (m *Model) Matrix() (rows [][]float64) {
+	n := m.alpha.Len()
+	gram := expandTable(m.table, n)
+	for i, v := range gram {
+		// Trainer.Compile stores log(0) as math.SmallestNonzeroFloat64:
+		if v > 0 {
+			gram[i] = math.Inf(-1)
+		}
+	}
+	rows = make([][]float64, n)
+	for i := range rows {
+		rows[i] = gram[i*n : i*n+n : i*n+n]
+	}
+	return rows
+}
+
+// Counts returns the number of times the Trainer has seen each transition,
+// plus the pair weight, laid out in the same way as Model.Matrix. Augmented
+// transitions are included with their weight.
+func (t *Trainer) Counts() (rows [][]float64) {
+	n := t.alpha.Len()
+	rows = make([][]float64, n)
+	for i := range rows {
+		rows[i] = make([]float64, n)
+		if t.gram != nil {
+			copy(rows[i], t.gram[i*n:i*n+n])
+			continue
+		}
+		for j := range rows[i] {
+			rows[i][j] = t.counts[i*n+j] + t.pairWeight
+		}
+	}
+	return rows
+}
+
+// MatrixFromCounts returns the log probabilities for counts, laid out as
+// returned by Trainer.Counts, after adding pairWeight to every count. The
+// counts must not be negative, and with a pairWeight of 0 they must not be 0,
+// as that would make the transition impossible.
+func MatrixFromCounts(counts [][]float64, pairWeight float64) (rows [][]float64, err error) {
+	if err := checkFinite("pair weight", pairWeight); err != nil {
+		return nil, err
+	}
+	if pairWeight < 0 {
+		return nil, fmt.Errorf("gibberdet: negative pair weight %g", pairWeight)
+	}
+	rows = make([][]float64, len(counts))
+	for i, row := range counts {
+		var sum float64
+		for j, c := range row {
+			if err := checkFinite("counts", c); err != nil {
+				return nil, err
+			}
+			if c < 0 {
+				return nil, fmt.Errorf("gibberdet: negative count in row %d, column %d", i, j)
+			}
+			if c+pairWeight == 0 {
+				return nil, fmt.Errorf("gibberdet: count of 0 in row %d, column %d with no pair weight", i, j)
+			}
+			sum += c + pairWeight
+		}
+		rows[i] = make([]float64, len(row))
+		for j, c := range row {
+			rows[i][j] = math.Log((c + pairWeight) / sum)
+		}
+	}
+	return rows, nil
+}
+
+// NewModelFromMatrix returns a model that uses alpha, with the transition log
+// probabilities in rows, laid out as returned by Model.Matrix. The
+// probabilities in each row must sum to 1. Use -Inf for transitions that were
+// never seen; they are stored the same way as Trainer.Compile stores them.
+//
+// The model uses StorageAuto, and does not share rows.
+func NewModelFromMatrix(alpha Alphabet, rows [][]float64) (*Model, error) {
+	n := alpha.Len()
+	if len(rows) != n {
+		return nil, fmt.Errorf("gibberdet: expected %d rows in matrix, found %d", n, len(rows))
+	}
+
+	gram := make([]float64, 0, n*n)
+	for i, row := range rows {
+		if len(row) != n {
+			return nil, fmt.Errorf("gibberdet: expected %d columns in matrix row %d, found %d", n, i, len(row))
+		}
+		var sum float64
+		for _, p := range row {
+			if math.IsInf(p, -1) {
+				gram = append(gram, math.SmallestNonzeroFloat64)
+				continue
+			}
+			if err := checkFinite("matrix", p); err != nil {
+				return nil, err
+			}
+			if p > 0 {
+				return nil, fmt.Errorf("gibberdet: log probability %g in matrix row %d is greater than 0", p, i)
+			}
+			sum += math.Exp(p)
+			gram = append(gram, p)
+		}
+		if math.Abs(sum-1) > matrixTolerance {
+			return nil, fmt.Errorf("gibberdet: probabilities in matrix row %d sum to %g, not 1", i, sum)
+		}
+	}
+
+	table, err := newTable(gram, n, StorageAuto.resolve(n))
+	if err != nil {
+		return nil, err
+	}
+	m := &Model{}
+	if err := m.decoded(alpha, table, map[string]string{MetaAlphabet: AlphabetKind(alpha)}); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WriteMatrix writes rows, laid out as returned by Model.Matrix or
+// Trainer.Counts, as CSV, or TSV if comma is '\t'. The first row and the first
+// column label each symbol in alpha with its spec (see AlphabetSpec), i.e. 'a'
+// or '[aA]'. The top left cell holds AlphabetKind(alpha). Transitions that were
+// never seen are written as -Inf.
+func WriteMatrix(w io.Writer, alpha Alphabet, rows [][]float64, comma rune) error {
+	n := alpha.Len()
+	if len(rows) != n {
+		return fmt.Errorf("gibberdet: expected %d rows in matrix, found %d", n, len(rows))
+	}
+	labels := matrixLabels(alpha)
+
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	record := make([]string, n+1)
+	record[0] = AlphabetKind(alpha)
+	copy(record[1:], labels)
+	if err := cw.Write(record); err != nil {
+		return err
+	}
+	for i, row := range rows {
+		if len(row) != n {
+			return fmt.Errorf("gibberdet: expected %d columns in matrix row %d, found %d", n, i, len(row))
+		}
+		record[0] = labels[i]
+		for j, v := range row {
+			record[j+1] = strconv.FormatFloat(v, 'g', -1, 64)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadMatrix reads a matrix written by WriteMatrix, and the alphabet from its
+// labels. If the top left cell is 'bytes', the alphabet is ByteAlphabet. The
+// rows are not checked; see NewModelFromMatrix.
+func ReadMatrix(r io.Reader, comma rune) (alpha Alphabet, rows [][]float64, err error) {
+	cr := csv.NewReader(r)
+	cr.Comma = comma
+	cr.ReuseRecord = true
+
+	header, err := cr.Read()
+	if err == io.EOF {
+		return nil, nil, fmt.Errorf("gibberdet: empty matrix")
+	} else if err != nil {
+		return nil, nil, err
+	}
+	labels := append([]string(nil), header[1:]...)
+
+	// Spreadsheets may add a byte order mark:
+	if strings.TrimPrefix(header[0], "\uFEFF") == AlphabetKind(ByteAlphabet) {
+		alpha = ByteAlphabet
+		if len(labels) != alpha.Len() {
+			return nil, nil, fmt.Errorf("gibberdet: expected %d labels for ByteAlphabet, found %d", alpha.Len(), len(labels))
+		}
+	} else if alpha, err = matrixAlphabet(labels); err != nil {
+		return nil, nil, err
+	}
+
+	for {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, err
+		}
+		i := len(rows)
+		if i >= len(labels) {
+			return nil, nil, fmt.Errorf("gibberdet: expected %d rows in matrix", len(labels))
+		}
+		if record[0] != labels[i] {
+			return nil, nil, fmt.Errorf("gibberdet: expected matrix row %d to be labelled %q, found %q", i, labels[i], record[0])
+		}
+		row := make([]float64, len(labels))
+		for j, field := range record[1:] {
+			if row[j], err = strconv.ParseFloat(strings.TrimSpace(field), 64); err != nil {
+				return nil, nil, fmt.Errorf("gibberdet: invalid value in matrix row %d, column %d: %q", i, j, field)
+			}
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) != len(labels) {
+		return nil, nil, fmt.Errorf("gibberdet: expected %d rows in matrix, found %d", len(labels), len(rows))
+	}
+	return alpha, rows, nil
+}
+
+func matrixLabels(alpha Alphabet) []string {
+	var classes [][]rune
+	if ca, ok := alpha.(classAlphabet); ok {
+		classes = ca.Classes()
+	}
+
+	labels := make([]string, alpha.Len())
+	var sb strings.Builder
+	for i, rn := range alpha.Runes() {
+		sb.Reset()
+		if classes != nil && len(classes[i]) > 1 {
+			sb.WriteByte('[')
+			writeSpecRunes(&sb, classes[i])
+			sb.WriteByte(']')
+		} else {
+			writeSpecRune(&sb, rn)
+		}
+		labels[i] = sb.String()
+	}
+	return labels
+}
+
+// matrixAlphabet builds the alphabet from the labels written by
+// matrixLabels. Each label is parsed on its own, so that an unescaped '-'
+// can't join its neighbours into a range.
+func matrixAlphabet(labels []string) (Alphabet, error) {
+	var sb strings.Builder
+	for _, label := range labels {
+		a, err := ParseAlphabetSpec(label)
+		if err != nil {
+			return nil, err
+		}
+		if a.Len() != 1 {
+			return nil, fmt.Errorf("gibberdet: matrix label %q is not a single symbol", label)
+		}
+		sb.WriteString(AlphabetSpec(a))
+	}
+	alpha, err := ParseAlphabetSpec(sb.String())
+	if err != nil {
+		return nil, err
+	}
+	if alpha.Len() != len(labels) {
+		return nil, fmt.Errorf("gibberdet: matrix labels are not unique")
+	}
+	return alpha, nil
+}
@@ -0,0 +1,220 @@
+package gibberdet
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMatrixRoundTrip(t *testing.T) {
+	models := goSourceTestModels(t)
+	models["Gutenberg"] = loadTestModel(t, "gutenberg-en.gibber")
+	models["OANC"] = loadTestModel(t, "oanc-en.gibber")
+
+	tr := NewTrainer(NewAlphabet([]rune("a-,\"\t\n\\[] é")))
+	if err := tr.Add(strings.NewReader("a-a, \"é\"\n[\\]\t-,a é")); err != nil {
+		t.Fatal(err)
+	}
+	escapes, err := tr.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	models["Escapes"] = escapes
+
+	for name, m := range models {
+		for _, comma := range []rune{',', '\t'} {
+			var buf bytes.Buffer
+			if err := WriteMatrix(&buf, m.Alphabet(), m.Matrix(), comma); err != nil {
+				t.Fatal(name, err)
+			}
+			alpha, rows, err := ReadMatrix(&buf, comma)
+			if err != nil {
+				t.Fatal(name, err)
+			}
+			if AlphabetSpec(alpha) != AlphabetSpec(m.Alphabet()) || AlphabetKind(alpha) != AlphabetKind(m.Alphabet()) {
+				t.Fatal(name, AlphabetSpec(alpha))
+			}
+			if !reflect.DeepEqual(rows, m.Matrix()) {
+				t.Fatal(name, "rows do not match")
+			}
+
+			if s := m.Storage(); s == StorageQuant16 || s == StorageQuant8 {
+				continue
+			}
+			out, err := NewModelFromMatrix(alpha, rows)
+			if err != nil {
+				t.Fatal(name, err)
+			}
+			if !reflect.DeepEqual(out.Matrix(), m.Matrix()) {
+				t.Fatal(name, "matrix does not match")
+			}
+			for _, s := range []string{"hello world", "中国人", "a-a, \"é\""} {
+				if out.GibberScore(s) != m.GibberScore(s) {
+					t.Fatal(name, s, out.GibberScore(s), m.GibberScore(s))
+				}
+			}
+		}
+	}
+
+	// Too lossy; see Model.Matrix
+	quant, err := models["Gutenberg"].Convert(StorageQuant8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewModelFromMatrix(quant.Alphabet(), quant.Matrix()); err == nil {
+		t.Fatal()
+	}
+
+	// The OANC model has transitions that were never seen:
+	var buf bytes.Buffer
+	if err := WriteMatrix(&buf, models["OANC"].Alphabet(), models["OANC"].Matrix(), ','); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), ",-Inf") {
+		t.Fatal("expected -Inf in OANC matrix")
+	}
+}
+
+func TestTrainerCounts(t *testing.T) {
+	for _, s := range []Storage{StorageDense, StorageSparse} {
+		tr := NewTrainer(NewAlphabet([]rune("ab")), TrainerPairWeight(1), TrainerStorage(s))
+		if err := tr.Add(strings.NewReader("abba")); err != nil {
+			t.Fatal(err)
+		}
+		counts := tr.Counts()
+		if !reflect.DeepEqual(counts, [][]float64{{1, 2}, {2, 2}}) {
+			t.Fatal(s, counts)
+		}
+
+		rows, err := MatrixFromCounts(counts, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := NewModelFromMatrix(tr.alpha, rows)
+		if err != nil {
+			t.Fatal(err)
+		}
+		compiled, err := tr.Compile()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(m.Matrix(), compiled.Matrix()) {
+			t.Fatal(s, m.Matrix(), compiled.Matrix())
+		}
+	}
+}
+
+func TestNewModelFromMatrixErrors(t *testing.T) {
+	alpha := NewAlphabet([]rune("ab"))
+	half := math.Log(0.5)
+	for _, tc := range []struct {
+		rows [][]float64
+		err  string
+	}{
+		{[][]float64{{half, half}}, "2 rows"},
+		{[][]float64{{half, half}, {0}}, "2 columns"},
+		{[][]float64{{half, half}, {half, math.NaN()}}, "NaN"},
+		{[][]float64{{half, half}, {half, math.Log(0.6)}}, "row 1 sum to 1.1"},
+		{[][]float64{{half, half}, {0, math.SmallestNonzeroFloat64}}, "greater than 0"},
+		{[][]float64{{half, half}, {0.1, math.Log(0.9)}}, "greater than 0"},
+		{[][]float64{{half, half}, {math.Inf(1), math.Inf(-1)}}, "+Inf"},
+		{[][]float64{{half, half}, {math.Inf(-1), math.Inf(-1)}}, "row 1 sum to 0"},
+	} {
+		_, err := NewModelFromMatrix(alpha, tc.rows)
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Fatal(tc.rows, err)
+		}
+	}
+}
+
+func TestMatrixFromCounts(t *testing.T) {
+	tr := NewTrainer(NewAlphabet([]rune("abc")))
+	if err := tr.Add(strings.NewReader("abcabcabcab")); err != nil {
+		t.Fatal(err)
+	}
+	counts := tr.Counts()
+	model := func(pairWeight float64) *Model {
+		t.Helper()
+		rows, err := MatrixFromCounts(counts, pairWeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m, err := NewModelFromMatrix(tr.alpha, rows)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return m
+	}
+	before := model(0).GibberScore("abcab")
+
+	// Setting a count to 0 bans the transition, as far as the pair weight
+	// allows:
+	counts[0][1] = 0
+	if _, err := MatrixFromCounts(counts, 0); err == nil || !strings.Contains(err.Error(), "count of 0") {
+		t.Fatal(err)
+	}
+	if after := model(1).GibberScore("abcab"); after >= before {
+		t.Fatal(after, before)
+	}
+
+	for _, tc := range []struct {
+		counts     [][]float64
+		pairWeight float64
+		err        string
+	}{
+		{[][]float64{{-1, 2}, {1, 1}}, 0, "negative count"},
+		{[][]float64{{1, 2}, {1, math.Inf(1)}}, 0, "+Inf"},
+		{[][]float64{{1, 2}, {1, 1}}, -1, "negative pair weight"},
+	} {
+		if _, err := MatrixFromCounts(tc.counts, tc.pairWeight); err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Fatal(tc.counts, err)
+		}
+	}
+}
+
+func TestReadMatrixErrors(t *testing.T) {
+	for _, tc := range []struct {
+		in  string
+		err string
+	}{
+		{"", "empty"},
+		{"ascii,a,b\na,0,0\n", "expected 2 rows"},
+		{"ascii,a,b\na,0,0\nb,0,0\nc,0,0\n", "expected 2 rows"},
+		{"ascii,a,b\nb,0,0\na,0,0\n", "labelled"},
+		{"ascii,a,b\na,0,x\nb,0,0\n", "row 0, column 1"},
+		{"ascii,a,b\na,0,0\nb,0\n", "wrong number of fields"},
+		{"ascii,a,a\na,0,0\na,0,0\n", "not unique"},
+		{"ascii,ab,c\nab,0,0\nc,0,0\n", "single symbol"},
+		{"bytes,a,b\na,0,0\nb,0,0\n", "256 labels"},
+	} {
+		_, _, err := ReadMatrix(strings.NewReader(tc.in), ',')
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Fatalf("%q: %v", tc.in, err)
+		}
+	}
+
+	// A '-' is not a range:
+	alpha, _, err := ReadMatrix(strings.NewReader("ascii,a,-,c\na,0,0,0\n-,0,0,0\nc,0,0,0\n"), ',')
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(alpha.Runes()) != "a-c" {
+		t.Fatal(string(alpha.Runes()))
+	}
+
+	// A byte order mark is ignored:
+	var buf bytes.Buffer
+	buf.WriteString("\uFEFF")
+	rows := make([][]float64, 256)
+	for i := range rows {
+		rows[i] = make([]float64, 256)
+	}
+	if err := WriteMatrix(&buf, ByteAlphabet, rows, ','); err != nil {
+		t.Fatal(err)
+	}
+	if alpha, _, err = ReadMatrix(&buf, ','); err != nil || alpha != ByteAlphabet {
+		t.Fatal(alpha, err)
+	}
+}
@@ -2,6 +2,7 @@ package gibberdet
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
 	"encoding/binary"
 	"fmt"
@@ -10,9 +11,31 @@ import (
 )
 
 type Model struct {
-	alpha          Alphabet
-	ascii          *asciiAlphabet
-	gram           []float64
+	alpha Alphabet
+	ascii *asciiAlphabet
+	wide  *runeAlphabet
+	table gramTable
+
+	// If the table uses StorageDense, gram is the table's backing slice. This
+	// allows the ASCII path to skip the gramTable interface.
+	gram []float64
+
+	// Likewise for StorageFloat32.
+	gram32 []float32
+
+	// Position of OtherRune in alpha, or -1.
+	other int
+
+	// Decode table used for input in a single-byte encoding, or nil for
+	// UTF-8. See WithEncoding.
+	encoding Encoding
+	charset  *[256]rune
+
+	// See WithCompat.
+	compat CompatMode
+
+	meta map[string]string
+
 	zeroGram       float64
 	gibberStringFn func(string) float64
 }
@@ -21,9 +44,40 @@ func (m *Model) init() {
 	const zeroGramWeight = 2
 	m.zeroGram = math.Log(1/(float64(m.alpha.Len()))) * zeroGramWeight
 
+	if m.table == nil {
+		m.table = &denseTable{gram: m.gram, n: m.alpha.Len()}
+	}
+	m.gram, m.gram32 = nil, nil
+	switch table := m.table.(type) {
+	case *denseTable:
+		m.gram = table.gram
+	case *float32Table:
+		m.gram32 = table.gram
+	}
+
+	m.other = m.alpha.FindRune(OtherRune)
+
+	// The byte paths would count each byte of a multi-byte rune as OTHER, so
+	// they are only used if the alphabet doesn't have it. They don't depend on
+	// the encoding: every supported encoding is a superset of ASCII, and
+	// other bytes are never in an asciiAlphabet.
 	var ok bool
-	if m.ascii, ok = m.alpha.(*asciiAlphabet); ok {
-		m.gibberStringFn = m.gibberStringScoreByByte
+	if m.compat == CompatPython {
+		m.gibberStringFn = m.gibberStringScorePython
+	} else if _, ok = m.alpha.(*byteAlphabet); ok {
+		m.gibberStringFn = m.gibberStringScoreByOctet
+	} else if m.ascii, ok = m.alpha.(*asciiAlphabet); ok && m.other < 0 {
+		if m.gram != nil {
+			m.gibberStringFn = m.gibberStringScoreByByte
+		} else if m.gram32 != nil {
+			m.gibberStringFn = m.gibberStringScoreByByteFloat32
+		} else {
+			m.gibberStringFn = m.gibberStringScoreByByteTable
+		}
+	} else if m.charset != nil {
+		m.gibberStringFn = m.gibberStringScoreByCharset
+	} else if m.wide, ok = m.alpha.(*runeAlphabet); ok && m.gram != nil {
+		m.gibberStringFn = m.gibberStringScoreByRuneDense
 	} else {
 		m.gibberStringFn = m.gibberStringScoreByRune
 	}
@@ -33,6 +87,53 @@ func (m *Model) Alphabet() Alphabet {
 	return m.alpha
 }
 
+// Storage reports how the model's transition table is stored.
+func (m *Model) Storage() Storage {
+	return m.table.storage()
+}
+
+// Convert returns a copy of the model with its transitions stored using s.
+// Converting to StorageFloat32, StorageQuant16 or StorageQuant8 loses
+// precision, which is not regained by converting back.
+func (m *Model) Convert(s Storage) (*Model, error) {
+	n := m.alpha.Len()
+	s = s.resolve(n)
+
+	table, err := newTable(expandTable(m.table, n), n, s)
+	if err != nil {
+		return nil, err
+	}
+	out := &Model{
+		alpha:    m.alpha,
+		table:    table,
+		encoding: m.encoding,
+		charset:  m.charset,
+		compat:   m.compat,
+		meta:     m.meta,
+	}
+	out.init()
+	return out, nil
+}
+
+// Encoding reports the encoding the model expects its input in.
+func (m *Model) Encoding() Encoding {
+	return m.encoding
+}
+
+// WithEncoding returns a copy of the model that decodes the strings passed to
+// GibberScore and GibberScoreBytes using e instead of UTF-8. The transitions
+// are shared with m.
+func (m *Model) WithEncoding(e Encoding) (*Model, error) {
+	charset, err := e.charset()
+	if err != nil {
+		return nil, err
+	}
+	out := m.WithMetadata(map[string]string{MetaEncoding: e.String()})
+	out.encoding, out.charset = e, charset
+	out.init()
+	return out, nil
+}
+
 func (m *Model) Test(goodInput []string, badInput []string) (thresh float64, err error) {
 	if len(goodInput) == 0 || len(badInput) == 0 {
 		return 0, fmt.Errorf("gibberdet: empty test")
@@ -111,18 +212,86 @@ done:
 	return expFast(logProb / float64(len(s)-1))
 }
 
+// gibberStringScoreByByteFloat32 is the same as gibberStringScoreByByte, but
+// for StorageFloat32.
+func (m *Model) gibberStringScoreByByteFloat32(s string) float64 {
+	if len(s) < 2 {
+		return 0
+	}
+
+	var logProb float64
+	var alphaLen = m.ascii.Len()
+	alphaA := m.ascii.FindByte(s[0])
+	for i := 1; i < len(s); i++ {
+		alphaB := m.ascii.FindByte(s[i])
+		if alphaA < 0 || alphaB < 0 {
+			logProb += m.zeroGram
+		} else {
+			logProb += float64(m.gram32[alphaA*alphaLen+alphaB])
+		}
+		alphaA = alphaB
+	}
+
+	return expFast(logProb / float64(len(s)-1))
+}
+
+// gibberStringScoreByByteTable is the same as gibberStringScoreByByte, but
+// for tables other than StorageDense.
+func (m *Model) gibberStringScoreByByteTable(s string) float64 {
+	if len(s) < 2 {
+		return 0
+	}
+
+	var logProb float64
+	alphaA := m.ascii.FindByte(s[0])
+	for i := 1; i < len(s); i++ {
+		alphaB := m.ascii.FindByte(s[i])
+		if alphaA < 0 || alphaB < 0 {
+			logProb += m.zeroGram
+		} else {
+			logProb += m.table.logProb(alphaA, alphaB)
+		}
+		alphaA = alphaB
+	}
+
+	return expFast(logProb / float64(len(s)-1))
+}
+
+// gibberStringScoreByOctet scores the raw bytes of s for ByteAlphabet, where
+// each byte is its own position in the alphabet.
+func (m *Model) gibberStringScoreByOctet(s string) float64 {
+	if len(s) < 2 {
+		return 0
+	}
+
+	var logProb float64
+	if m.gram != nil {
+		for i := 1; i < len(s); i++ {
+			logProb += m.gram[int(s[i-1])<<8|int(s[i])]
+		}
+	} else {
+		for i := 1; i < len(s); i++ {
+			logProb += m.table.logProb(int(s[i-1]), int(s[i]))
+		}
+	}
+
+	return expFast(logProb / float64(len(s)-1))
+}
+
 func (m *Model) gibberStringScoreByRune(s string) float64 {
 	// Return the average transition prob from l through log_prob_mat.
 	var logProb float64
 
 	var last int
 	var first = true
-	var alphaLen = m.alpha.Len()
 	var i int
 	var r rune
 
 	for i, r = range s {
 		alphaIdx := m.alpha.FindRune(r)
+		if alphaIdx < 0 {
+			alphaIdx = m.other
+		}
 		if alphaIdx < 0 {
 			if !first {
 				first = true
@@ -132,7 +301,7 @@ func (m *Model) gibberStringScoreByRune(s string) float64 {
 		if first {
 			first = false
 		} else {
-			logProb += m.gram[last*alphaLen+alphaIdx]
+			logProb += m.table.logProb(last, alphaIdx)
 		}
 		last = alphaIdx
 	}
@@ -144,6 +313,72 @@ func (m *Model) gibberStringScoreByRune(s string) float64 {
 	return expFast(logProb / float64(len(s)-1))
 }
 
+// gibberStringScoreByCharset is the same as gibberStringScoreByRune, but
+// decodes s using a single-byte encoding.
+func (m *Model) gibberStringScoreByCharset(s string) float64 {
+	var logProb float64
+
+	var last int
+	var first = true
+
+	for i := 0; i < len(s); i++ {
+		alphaIdx := m.alpha.FindRune(m.charset[s[i]])
+		if alphaIdx < 0 {
+			alphaIdx = m.other
+		}
+		if alphaIdx < 0 {
+			first = true
+			continue
+		}
+		if first {
+			first = false
+		} else {
+			logProb += m.table.logProb(last, alphaIdx)
+		}
+		last = alphaIdx
+	}
+	if len(s) < 3 {
+		return 0
+	}
+
+	return expFast(logProb / float64(len(s)-1))
+}
+
+// gibberStringScoreByRuneDense is the same as gibberStringScoreByRune, but
+// avoids the interface calls for the common case of a runeAlphabet with
+// StorageDense.
+func (m *Model) gibberStringScoreByRuneDense(s string) float64 {
+	var logProb float64
+
+	var last int
+	var first = true
+	var alphaLen = len(m.wide.runes)
+	var i int
+	var r rune
+
+	for i, r = range s {
+		alphaIdx := m.wide.FindRune(r)
+		if alphaIdx < 0 {
+			alphaIdx = m.other
+		}
+		if alphaIdx < 0 {
+			first = true
+			continue
+		}
+		if first {
+			first = false
+		} else {
+			logProb += m.gram[last*alphaLen+alphaIdx]
+		}
+		last = alphaIdx
+	}
+	if i < 2 {
+		return 0
+	}
+
+	return expFast(logProb / float64(len(s)-1))
+}
+
 func (m *Model) MarshalText() (data []byte, err error) {
 	bts, err := m.MarshalBinary()
 	if err != nil {
@@ -161,69 +396,103 @@ func (m *Model) UnmarshalText(data []byte) (err error) {
 }
 
 func (m *Model) MarshalBinary() (data []byte, err error) {
-	alpha, err := marshalAlphabet(m.alpha)
-	if err != nil {
+	var buf bytes.Buffer
+	if _, err := m.WriteTo(&buf); err != nil {
 		return nil, err
 	}
+	return buf.Bytes(), nil
+}
 
-	var enc = make([]byte, 8)
+// marshalBody returns the alphabet and the table, which are the same in all
+// versions of the format.
+func (m *Model) marshalBody() (data []byte, err error) {
 	var buf bytes.Buffer
-
-	binary.LittleEndian.PutUint32(enc, uint32(len(alpha)))
-	buf.Write(enc[:4])
-
-	buf.Write(alpha)
-
-	binary.LittleEndian.PutUint32(enc, uint32(len(m.gram)))
-	buf.Write(enc[:4])
-
-	for _, f := range m.gram {
-		bits := math.Float64bits(f)
-		binary.LittleEndian.PutUint64(enc, bits)
-		buf.Write(enc)
+	mw := newModelWriter(&buf)
+	if err := m.writeBody(mw); err != nil {
+		return nil, err
 	}
-
-	var outer bytes.Buffer
-	outer.WriteString("gibbermodel!")
-	binary.LittleEndian.PutUint32(enc, uint32(buf.Len()))
-	outer.Write(enc[:4])
-	outer.Write(buf.Bytes())
-
-	return outer.Bytes(), nil
+	if err := mw.w.Flush(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
 }
 
+// UnmarshalBinary reads models written by MarshalBinary, in any version of
+// the format.
+//
+// Decoding is strict: it returns ErrBadMagic, ErrTruncated or ErrChecksum, or
+// an error describing the problem, for any data that MarshalBinary couldn't
+// have written, and never panics.
 func (m *Model) UnmarshalBinary(data []byte) (err error) {
-	if !bytes.HasPrefix(data, []byte("gibbermodel!")) {
-		return fmt.Errorf("gibberdet: model does not start with 'gibbermodel!'")
+	return m.unmarshalBinary(data, false)
+}
+
+func (m *Model) unmarshalBinary(data []byte, noCopy bool) (err error) {
+	if !bytes.HasPrefix(data, []byte(formatMagic)) {
+		return ErrBadMagic
 	}
 
-	pos := len("gibbermodel!")
-	sz := int(binary.LittleEndian.Uint32(data[pos:]))
-	if len(data)-pos < sz {
-		return fmt.Errorf("gibberdet: model size mismatch")
+	pos := len(formatMagic)
+	if len(data)-pos < 4 {
+		return ErrTruncated
 	}
+	sz := binary.LittleEndian.Uint32(data[pos:])
 	pos += 4
 
-	alphaSz := int(binary.LittleEndian.Uint32(data[pos:]))
-	pos += 4
-	alpha := bytes.Runes(data[pos : pos+alphaSz])
-	pos += alphaSz
+	// The checksum is checked before anything else, unlike ReadFrom, so that
+	// corrupted data is reported as ErrChecksum:
+	legacySize := int64(-1)
+	if sz == formatMarker {
+		if len(data)-pos < sha256.Size {
+			return ErrTruncated
+		}
+		end := len(data) - sha256.Size
+		if sum := sha256.Sum256(data[:end]); !bytes.Equal(sum[:], data[end:]) {
+			return ErrChecksum
+		}
 
-	gramSz := int(binary.LittleEndian.Uint32(data[pos:]))
-	pos += 4
+	} else {
+		// Version 1 sizes are not checked exactly:
+		if uint64(len(data)-pos+4) < uint64(sz) {
+			return ErrTruncated
+		}
+		legacySize = int64(len(data) - pos)
+	}
+
+	return m.decode(newModelReaderBytes(data, noCopy), legacySize)
+}
 
-	grams := make([]float64, 0, gramSz)
-	if pos+(gramSz*8) != len(data) {
-		return fmt.Errorf("gibberdet: gram data size mismatch")
+// decoded replaces m with a model using the alphabet, table and metadata
+// decoded by UnmarshalBinary or UnmarshalJSON. The input encoding and
+// CompatMode are restored from the metadata.
+func (m *Model) decoded(alpha Alphabet, table gramTable, meta map[string]string) (err error) {
+	encoding := EncodingUTF8
+	if name, ok := meta[MetaEncoding]; ok {
+		if encoding, err = ParseEncoding(name); err != nil {
+			return err
+		}
 	}
-	for ; pos < len(data); pos += 8 {
-		u := binary.LittleEndian.Uint64(data[pos:])
-		grams = append(grams, math.Float64frombits(u))
+	compat := CompatNone
+	if name, ok := meta[MetaCompat]; ok {
+		if compat, err = ParseCompatMode(name); err != nil {
+			return err
+		}
+		if err := compat.check(alpha); err != nil {
+			return err
+		}
+	}
+	charset, err := encoding.charset()
+	if err != nil {
+		return err
 	}
 
 	*m = Model{
-		alpha: NewAlphabet(alpha),
-		gram:  grams,
+		alpha:    alpha,
+		table:    table,
+		encoding: encoding,
+		charset:  charset,
+		compat:   compat,
+		meta:     meta,
 	}
 	m.init()
 
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"math"
+	"math/rand"
 	"strings"
 	"testing"
 )
@@ -22,7 +24,7 @@ func findPair(m *Model, ab string) float64 {
 	if bi < 0 {
 		return -1
 	}
-	return m.gram[ai*m.alpha.Len()+bi]
+	return m.table.logProb(ai, bi)
 }
 
 func TestModelASCIIFindGram(t *testing.T) {
@@ -73,6 +75,313 @@ func TestModelRuneFindGram(t *testing.T) {
 	}
 }
 
+func TestModelSparse(t *testing.T) {
+	corpus, err := ioutil.ReadFile("README.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, alpha := range []Alphabet{ASCIIAlnum, MergeAlphabet(ASCIIAlpha, miscChineseAlpha)} {
+		dense, err := Train(alpha, bytes.NewReader(corpus))
+		if err != nil {
+			t.Fatal(err)
+		}
+		tr := NewTrainer(alpha, TrainerStorage(StorageSparse))
+		if err := tr.Add(bytes.NewReader(corpus)); err != nil {
+			t.Fatal(err)
+		}
+		sparse, err := tr.Compile()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if dense.Storage() != StorageDense || sparse.Storage() != StorageSparse {
+			t.Fatal(dense.Storage(), sparse.Storage())
+		}
+
+		bts, err := sparse.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var loaded Model
+		if err := loaded.UnmarshalBinary(bts); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, in := range []string{"hello world", "2c38qnuonuf", "天地玄黃 hello", "x"} {
+			ds, ss, ls := dense.GibberScore(in), sparse.GibberScore(in), loaded.GibberScore(in)
+			if math.Abs(ds-ss) > 1e-9 || ss != ls {
+				t.Fatal(in, ds, ss, ls)
+			}
+		}
+	}
+}
+
+func TestModelConvert(t *testing.T) {
+	b, _ := ioutil.ReadFile("testdata/oanc-en.gibber")
+	var ref Model
+	if err := ref.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+
+	samples := []string{"hello world", "test", "it's", "2c38qnuonuf", "*)J(*&)(J", "snake_case_words"}
+
+	for _, tc := range []struct {
+		storage Storage
+		maxErr  float64
+	}{
+		{StorageDense, 0},
+		{StorageSparse, 0},
+		{StorageFloat32, 1e-6},
+		{StorageQuant16, 1e-5},
+		{StorageQuant8, 1e-2},
+	} {
+		t.Run(tc.storage.String(), func(t *testing.T) {
+			m, err := ref.Convert(tc.storage)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if m.Storage() != tc.storage {
+				t.Fatal(m.Storage())
+			}
+
+			bts, err := m.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var loaded Model
+			if err := loaded.UnmarshalBinary(bts); err != nil {
+				t.Fatal(err)
+			}
+
+			report := MeasureAccuracy(&ref, m, samples)
+			if report.MaxAbsError > tc.maxErr {
+				t.Fatal(report)
+			}
+			if report := MeasureAccuracy(m, &loaded, samples); report.MaxAbsError != 0 {
+				t.Fatal(report)
+			}
+		})
+	}
+}
+
+func TestModelProject(t *testing.T) {
+	m := loadTestModel(t, "gutenberg-en.gibber")
+
+	// Projecting onto the same runes in a different order shouldn't change
+	// the scores:
+	runes := append([]rune(nil), m.alpha.Runes()...)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	reversed, err := m.Project(NewAlphabet(runes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report := MeasureAccuracy(m, reversed, []string{"hello world", "2c38qnuonuf"}); report.MaxAbsError > 1e-12 {
+		t.Fatal(report)
+	}
+
+	noDigits, err := m.Project(AlphabetSubtract(m.alpha, NewAlphabet([]rune("0123456789"))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := noDigits.alpha.Len()
+	for from := 0; from < n; from++ {
+		var sum float64
+		for to := 0; to < n; to++ {
+			sum += math.Exp(noDigits.table.logProb(from, to))
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Fatal(from, sum)
+		}
+	}
+
+	if _, err := m.Project(NewAlphabet([]rune("ab天"))); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestModelProjectSentinel(t *testing.T) {
+	m := loadTestModel(t, "oanc-en.gibber")
+
+	runes := append([]rune(nil), m.alpha.Runes()...)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	reversed, err := m.Project(NewAlphabet(runes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report := MeasureAccuracy(m, reversed, []string{"hello world", "2c38qnuonuf", "qqqjjjxxx"}); report.MaxAbsError > 1e-12 {
+		t.Fatal(report)
+	}
+
+	noDigits, err := m.Project(AlphabetSubtract(m.alpha, NewAlphabet([]rune("0123456789"))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := noDigits.alpha.Len()
+	var sentinels int
+	for from := 0; from < n; from++ {
+		var sum float64
+		for to := 0; to < n; to++ {
+			lp := noDigits.table.logProb(from, to)
+			orig := m.table.logProb(m.alpha.FindRune(noDigits.alpha.Runes()[from]), m.alpha.FindRune(noDigits.alpha.Runes()[to]))
+			if (lp > 0) != (orig > 0) {
+				t.Fatal(from, to, lp, orig)
+			}
+			if lp > 0 {
+				sentinels++
+			}
+			sum += transitionProb(lp)
+		}
+		if math.Abs(sum-1) > 1e-9 {
+			t.Fatal(from, sum)
+		}
+	}
+	if sentinels == 0 {
+		t.Fatal("expected oanc to contain transitions that were never seen")
+	}
+}
+
+func TestModelClassAlphabet(t *testing.T) {
+	for idx, alpha := range []Alphabet{
+		ASCIIAlnumFolded,
+		NewClassAlphabet(append(FoldCase([]rune(alphaLower)), DigitClass, SpaceClass, []rune("天"))...),
+	} {
+		trn := NewTrainer(alpha)
+		if err := trn.Add(strings.NewReader("Hello World, the quick brown fox jumps over the lazy dog 42 times")); err != nil {
+			t.Fatal(err)
+		}
+		m, err := trn.Compile()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.GibberScore("HELLO world") != m.GibberScore("hello WORLD") {
+			t.Fatal(idx)
+		}
+		if m.GibberScore("fox 12") != m.GibberScore("fox 99") {
+			t.Fatal(idx)
+		}
+		if findPair(m, "He") != findPair(m, "hE") {
+			t.Fatal(idx)
+		}
+
+		bts, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out Model
+		if err := out.UnmarshalBinary(bts); err != nil {
+			t.Fatal(err)
+		}
+		if AlphabetSpec(out.alpha) != AlphabetSpec(alpha) {
+			t.Fatal(idx, out.alpha)
+		}
+		if out.GibberScore("HELLO World") != m.GibberScore("hello world") {
+			t.Fatal(idx)
+		}
+	}
+}
+
+func TestModelOther(t *testing.T) {
+	const corpus = "the café, the cafés; naïve... a façade! "
+
+	for idx, alpha := range []Alphabet{
+		WithOther(ASCIIAlpha),
+		WithOther(MergeAlphabet(ASCIIAlpha, miscChineseAlpha)),
+	} {
+		trn := NewTrainer(alpha)
+		if err := trn.Add(strings.NewReader(strings.Repeat(corpus, 10))); err != nil {
+			t.Fatal(err)
+		}
+		if st := trn.Stats(); st.Skipped != 0 || st.Sequences != 1 {
+			t.Fatal(idx, st.Skipped, st.Sequences)
+		}
+		m, err := trn.Compile()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// "é" and "," are both OTHER, so the transitions to them should be
+		// learned, and should be more likely than ones that weren't seen:
+		other := alpha.FindRune(OtherRune)
+		f, e := alpha.FindRune('f'), alpha.FindRune('e')
+		if m.table.logProb(f, other) <= m.table.logProb(f, e) {
+			t.Fatal(idx)
+		}
+
+		// Any unknown rune is scored the same way:
+		if m.GibberScore("café") != m.GibberScore("cafè") {
+			t.Fatal(idx)
+		}
+		if m.GibberScore("café") <= m.GibberScore("cafz") {
+			t.Fatal(idx, m.GibberScore("café"), m.GibberScore("cafz"))
+		}
+
+		bts, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out Model
+		if err := out.UnmarshalBinary(bts); err != nil {
+			t.Fatal(err)
+		}
+		if out.alpha.FindRune(OtherRune) != other || out.GibberScore("café") != m.GibberScore("café") {
+			t.Fatal(idx)
+		}
+	}
+}
+
+func TestModelByteAlphabet(t *testing.T) {
+	// Train on hex, which should make other bytes, including bytes that are
+	// invalid UTF-8, unlikely:
+	var corpus bytes.Buffer
+	for i := 0; i < 1000; i++ {
+		fmt.Fprintf(&corpus, "%x", i*7919)
+	}
+	trn := NewTrainer(ByteAlphabet)
+	if err := trn.Add(&corpus); err != nil {
+		t.Fatal(err)
+	}
+	if st := trn.Stats(); st.Invalid != 0 || st.Skipped != 0 || st.Runes != st.Bytes {
+		t.Fatal(st)
+	}
+	m, err := trn.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hex := []byte("3fa9c0de12")
+	raw := []byte{0xff, 0xfe, 0x00, 0x80, 0xc3, 0x10, 0x99, 0xa0, 0x01, 0x7f}
+	if m.GibberScoreBytes(hex) <= m.GibberScoreBytes(raw) {
+		t.Fatal(m.GibberScoreBytes(hex), m.GibberScoreBytes(raw))
+	}
+	if m.GibberScoreBytes(raw) != m.GibberScore(string(raw)) {
+		t.Fatal()
+	}
+
+	sparse, err := m.Convert(StorageSparse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report := MeasureAccuracy(m, sparse, []string{string(hex), string(raw)}); report.MaxAbsError > 1e-12 {
+		t.Fatal(report)
+	}
+
+	bts, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out Model
+	if err := out.UnmarshalBinary(bts); err != nil {
+		t.Fatal(err)
+	}
+	if out.alpha != ByteAlphabet || out.GibberScoreBytes(raw) != m.GibberScoreBytes(raw) {
+		t.Fatal()
+	}
+}
+
 func TestMarshalJSON(t *testing.T) {
 	b, _ := ioutil.ReadFile("testdata/oanc-en.gibber")
 	var m Model
@@ -159,6 +468,27 @@ func BenchmarkGibberScoreByteDelegate(b *testing.B) {
 	})
 }
 
+func BenchmarkGibberScoreByteStorage(b *testing.B) {
+	var m Model
+	bts, err := ioutil.ReadFile("testdata/gutenberg-en.gibber")
+	if err != nil {
+		panic(err)
+	}
+	if err := m.UnmarshalBinary(bts); err != nil {
+		panic(err)
+	}
+
+	for _, cm := range convertTestModel(b, &m) {
+		s := cm.Storage()
+		b.Run(s.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				BenchScoreResult = cm.GibberScore("hello world")
+			}
+		})
+	}
+}
+
 func BenchmarkGibberScoreRuneDelegate(b *testing.B) {
 	var m Model
 	bts, err := ioutil.ReadFile("testdata/test-cn.gibber")
@@ -176,3 +506,36 @@ func BenchmarkGibberScoreRuneDelegate(b *testing.B) {
 		BenchScoreResult = m.GibberScore("可界河落布意")
 	}
 }
+
+func BenchmarkGibberScoreRuneLarge(b *testing.B) {
+	// 1000 CJK ideographs is well past the trie's small alphabets, and still
+	// small enough to train with StorageDense:
+	runes := make([]rune, 1000)
+	for i := range runes {
+		runes[i] = 0x4E00 + rune(i)
+	}
+	rng := rand.New(rand.NewSource(1))
+	text := make([]rune, 200000)
+	for i := range text {
+		text[i] = runes[rng.Intn(len(runes))]
+	}
+
+	tr := NewTrainer(NewAlphabet(runes), TrainerStorage(StorageDense))
+	if err := tr.Add(strings.NewReader(string(text))); err != nil {
+		b.Fatal(err)
+	}
+	m, err := tr.Compile()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if m.wide == nil || m.gram == nil {
+		b.Fatal("expected gibberStringScoreByRuneDense")
+	}
+	input := string(text[:64])
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		BenchScoreResult = m.GibberScore(input)
+	}
+}
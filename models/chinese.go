@@ -0,0 +1,834 @@
+// Code generated by gibberdet.WriteGoSource; DO NOT EDIT.
+
+package models
+
+import (
+	"sync"
+
+	"github.com/shabbyrobe/gibberdet"
+)
+
+var (
+	chineseTestOnce  sync.Once
+	chineseTestModel *gibberdet.Model
+)
+
+// ChineseTest returns the compiled-in model. It is built on the first call, and
+// is safe for concurrent use.
+func ChineseTest() *gibberdet.Model {
+	chineseTestOnce.Do(func() {
+		m, err := gibberdet.NewModelFromData(&chineseTestData)
+		if err != nil {
+			panic(err)
+		}
+		chineseTestModel = m
+	})
+	return chineseTestModel
+}
+
+var chineseTestData = gibberdet.ModelData{
+	Alphabet: "裡縣戰來小景家動調所致斯影作取，的德放視至可界河落布意從據於建錢起口不根力這招黃李收雙酒生一名就際師出部史古院教持經業提創想了應化地產火；漸企黨會術天個：內過\\n",
+	Storage:  gibberdet.StorageDense,
+	Gram: []float64{
+		-4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.289213335068144,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.289213335068144,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.289213335068144,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.289213335068144, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716,
+		-4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716,
+		-4.290459441148391, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716,
+		-4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716,
+		-4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.290459441148391, -4.385769620952716, -4.385769620952716,
+		-4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716,
+		-4.385769620952716, -4.385769620952716, -4.385769620952716, -4.290459441148391, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716,
+		-4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716,
+		-4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716,
+		-4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716, -4.385769620952716,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.289213335068144, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.289213335068144, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.289213335068144, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.289213335068144, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469, -4.384523514872469,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.287965674269989, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314, -4.383275854074314,
+		-4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881,
+		-4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881,
+		-4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881,
+		-4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881,
+		-4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881,
+		-4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881,
+		-4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881,
+		-4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881,
+		-4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881,
+		-4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881, -4.382026634673881,
+	},
+}
@@ -0,0 +1,1116 @@
+// Code generated by gibberdet.WriteGoSource; DO NOT EDIT.
+
+package models
+
+import (
+	"sync"
+
+	"github.com/shabbyrobe/gibberdet"
+)
+
+var (
+	englishGutenbergOnce  sync.Once
+	englishGutenbergModel *gibberdet.Model
+)
+
+// EnglishGutenberg returns the compiled-in model. It is built on the first call, and
+// is safe for concurrent use.
+func EnglishGutenberg() *gibberdet.Model {
+	englishGutenbergOnce.Do(func() {
+		m, err := gibberdet.NewModelFromData(&englishGutenbergData)
+		if err != nil {
+			panic(err)
+		}
+		englishGutenbergModel = m
+	})
+	return englishGutenbergModel
+}
+
+var englishGutenbergData = gibberdet.ModelData{
+	Alphabet: "The ProjctGunbgEBkfAdvsSlHm\\nyiCaD(#15)pw.\",IY*WFVxR97!:M\\[6\\]N20LOJUKz\\t\\-X'q8;?Q&$43Z/+%<@>~_=^|",
+	Storage:  gibberdet.StorageDense,
+	Gram: []float64{
+		-6.53448626909029, -0.37615226974727456, -3.787215354834798, -4.522253477103904, -7.450777000964445, -3.8158258888760637, -3.101790220368763, -7.450777000964445,
+		-7.450777000964445, -7.450777000964445, -7.450777000964445, -4.023262310984916, -7.450777000964445, -7.450777000964445, -7.450777000964445, -3.338265134786895,
+		-7.450777000964445, -7.450777000964445, -7.450777000964445, -5.396653267268898, -7.450777000964445, -6.7576298204044996, -5.253552423628225, -6.015692475675122,
+		-7.450777000964445, -3.779552482089229, -7.450777000964445, -6.089800447828844, -6.287626191158764, -4.196534032258953, -7.188412736496954, -4.33726169175407,
+		-7.355466821160119, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.26845544417049, -4.833381168130366,
+		-6.115775934232104, -7.26845544417049, -6.53448626909029, -3.948227125042002, -5.861541795847864, -6.980773371718709, -6.980773371718709, -7.355466821160119,
+		-7.450777000964445, -7.450777000964445, -5.3466428466942375, -7.450777000964445, -7.450777000964445, -7.114304764343232, -7.188412736496954, -6.980773371718709,
+		-7.450777000964445, -7.450777000964445, -7.04531189285628, -6.8629903360623254, -7.450777000964445, -7.450777000964445, -6.8629903360623254, -5.3839142414914685,
+		-7.450777000964445, -5.578974824062853, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.355466821160119, -7.450777000964445, -7.450777000964445,
+		-7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445,
+		-7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445, -7.450777000964445,
+		-7.450777000964445, -7.355466821160119, -7.450777000964445, -7.450777000964445, -7.450777000964445, -10.269008752715365, -9.313497307687928, -0.7385723625489641,
+		-2.4704856990901582, -10.269008752715365, -4.565559666960401, -2.6075757934332495, -10.269008752715365, -8.152753237912812, -3.757114845698697, -10.269008752715365,
+		-4.687017335113086, -6.719391365934936, -7.4964200304755835, -10.17369857291104, -10.269008752715365, -10.269008752715365, -7.908154751597343, -7.898765011247504,
+		-10.269008752715365, -7.5609585516131546, -9.62715486654297, -6.313926257826772, -10.269008752715365, -6.639348658261399, -10.269008752715365, -6.28002470615109,
+		-4.969691885834244, -5.040041464302121, -2.005186388233433, -10.269008752715365, -1.9126652964419497, -10.269008752715365, -10.269008752715365, -10.269008752715365,
+		-9.932536516094151, -10.269008752715365, -9.239389335534206, -9.738380501653195, -7.490189480724947, -5.647965217570983, -9.313497307687928, -4.89141820527282,
+		-10.269008752715365, -10.269008752715365, -10.17369857291104, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365,
+		-10.269008752715365, -10.269008752715365, -7.151058846437124, -9.016245784219997, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365,
+		-10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365,
+		-10.269008752715365, -10.269008752715365, -7.058165099544428, -10.269008752715365, -8.639768212985084, -10.08668719592141, -10.269008752715365, -8.128942589219093,
+		-7.567647539763951, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365,
+		-10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -10.269008752715365, -9.105857942909683, -10.269008752715365,
+		-10.269008752715365, -10.269008752715365, -11.049562140023584, -6.497792730762607, -3.760497842811041, -1.2519185533218216, -11.049562140023584, -2.011767266598519,
+		-5.327285082731218, -8.24620175911705, -3.7568166061226673, -3.7815483457713612, -11.049562140023584, -6.293389080498964, -2.4306972281967143, -6.58941772608575,
+		-4.995827267189477, -11.049562140023584, -8.548126188284373, -7.081158801159329, -4.602731826353978, -11.049562140023584, -2.444467967432883, -4.185967756827216,
+		-2.573190943127601, -11.049562140023584, -3.483147610940174, -11.049562140023584, -3.837931608694915, -3.6328830445650517, -4.567372640856614, -4.507378405487934,
+		-11.049562140023584, -3.1380548533972967, -11.049562140023584, -10.867240583229629, -9.984851403031154, -11.049562140023584, -11.049562140023584, -8.633648361722534,
+		-4.4718398740337575, -4.763750282595059, -4.375000748209158, -8.302291225768093, -3.9994396197545243, -11.049562140023584, -11.049562140023584, -10.579558510777847,
+		-11.049562140023584, -11.049562140023584, -11.049562140023584, -4.506657996264356, -11.049562140023584, -11.049562140023584, -11.049562140023584, -6.720145455621999,
+		-7.888315427992019, -10.867240583229629, -10.954251960219258, -11.049562140023584, -10.71308990340237, -11.049562140023584, -11.049562140023584, -11.049562140023584,
+		-11.049562140023584, -11.049562140023584, -11.049562140023584, -11.049562140023584, -11.049562140023584, -7.76865092423593, -11.049562140023584, -6.136907254287531,
+		-11.049562140023584, -6.288243271545561, -6.302892391761793, -11.049562140023584, -7.119699216467106, -6.732074026487273, -11.049562140023584, -11.049562140023584,
+		-11.049562140023584, -11.049562140023584, -11.049562140023584, -11.049562140023584, -11.049562140023584, -11.049562140023584, -11.049562140023584, -11.049562140023584,
+		-11.049562140023584, -11.049562140023584, -11.049562140023584, -7.834694336552921, -10.954251960219258, -11.049562140023584, -11.049562140023584, -4.727703067045316,
+		-2.8742214708995775, -3.9697994766880424, -3.404742171491879, -5.075637563572379, -3.8281566671402283, -2.6808752178442985, -6.039484843422514, -3.3154890960573873,
+		-1.9329641724234707, -6.166564600765548, -4.5653366878621995, -4.016951889567301, -3.244099693090349, -4.289511403005563, -6.0072432447586115, -5.516836184119298,
+		-5.553728116625216, -3.367710397309467, -4.928676464427093, -3.660260887519625, -5.108895530726127, -2.8067093049544725, -5.161457354420049, -3.861593747785051,
+		-5.392491741453808, -3.5062761539208163, -8.49097549629067, -4.848951459458239, -2.9584399785800346, -5.599300466999297, -2.230900433296258, -6.000606484110829,
+		-6.535055253640256, -9.86328361543582, -5.83859351916741, -7.4371707028284995, -11.54968256900605, -3.583859256671384, -2.880214742148154, -9.508462240146411,
+		-5.337276894139058, -11.54968256900605, -4.553275592429349, -7.0085177129938705, -10.045605172229775, -5.644048278503261, -5.779800292645325, -6.753065918447003,
+		-10.556430795995766, -5.753624818240677, -8.610520646940452, -8.66488185615934, -11.54968256900605, -11.54968256900605, -5.386788906270536, -9.940244656571949,
+		-8.228250155812756, -11.454372389201724, -5.582254599726308, -6.885300523386112, -10.556430795995766, -6.508841432852728, -6.240425262419678, -6.954562718871459,
+		-7.05544394372524, -6.717376810434211, -9.179438827538188, -11.54968256900605, -10.268748723543984, -7.8434544765575005, -7.270242523107269, -6.283371998593099,
+		-8.770863297015632, -11.54968256900605, -11.54968256900605, -9.482819809533074, -10.96189590410393, -9.188828567888027, -7.0344370905459455, -7.038823062489199,
+		-9.15178729620768, -11.144217460897885, -10.716773446070945, -11.54968256900605, -11.367361012212095, -11.54968256900605, -11.454372389201724, -11.54968256900605,
+		-6.348426915301144, -10.633391837131894, -11.54968256900605, -8.068442479670358, -3.09978856829883, -4.050368373651617, -2.3042064958624344, -6.71295620067707,
+		-5.902025984460741, -1.100740919546359, -3.1248183163646654, -6.895277757471025, -6.895277757471025, -6.71295620067707, -6.48981264936286, -4.1480068432155335,
+		-6.253423871298629, -6.895277757471025, -6.895277757471025, -5.3692214539759755, -6.895277757471025, -6.895277757471025, -5.137419839918651, -4.907403409316679,
+		-6.895277757471025, -6.895277757471025, -6.307491092568905, -6.364649506408854, -3.9775070253867453, -5.436662734771508, -6.895277757471025, -6.7999675776667,
+		-5.830567020478596, -1.5703184599765665, -6.895277757471025, -2.508263581286104, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025,
+		-6.895277757471025, -6.895277757471025, -6.895277757471025, -6.632913493003533, -5.671502325848909, -6.895277757471025, -6.632913493003533, -5.830567020478596,
+		-6.48981264936286, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -4.654568068195066, -6.895277757471025,
+		-6.895277757471025, -6.895277757471025, -6.895277757471025, -6.364649506408854, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025,
+		-6.895277757471025, -6.895277757471025, -5.796665468802915, -4.935182973423754, -6.895277757471025, -5.306042552354444, -6.895277757471025, -6.895277757471025,
+		-6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025,
+		-6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025,
+		-6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025, -6.895277757471025,
+		-6.895277757471025, -10.32517699806576, -6.232500492944357, -1.4442994858899258, -1.9093523010378122, -10.32517699806576, -3.646709105158323, -2.3791257664235688,
+		-9.988704761444549, -4.333962482212988, -3.252415884499357, -10.32517699806576, -4.165447561153559, -3.9270813562096447, -6.02789159184697, -4.355191482634903,
+		-10.32517699806576, -10.32517699806576, -4.860074813872899, -5.373584244603288, -10.32517699806576, -3.7377647337749655, -4.913530946210721, -2.9607566542770685,
+		-10.32517699806576, -4.695399936720055, -10.32517699806576, -3.754434101557346, -4.29089245377485, -3.2709000664208565, -2.3834901838898466, -10.32517699806576,
+		-2.593377863721912, -10.32517699806576, -10.32517699806576, -10.06281273359827, -10.32517699806576, -10.32517699806576, -8.45337482116417, -5.434827869844007,
+		-6.338046520150809, -4.3942589508258125, -8.638778044495531, -4.065786756871676, -10.32517699806576, -10.32517699806576, -9.988704761444549, -10.32517699806576,
+		-10.32517699806576, -10.32517699806576, -10.142855441271806, -10.32517699806576, -10.32517699806576, -10.32517699806576, -6.917335073684937, -8.012641574218547,
+		-10.32517699806576, -10.142855441271806, -10.32517699806576, -10.142855441271806, -10.32517699806576, -10.32517699806576, -10.32517699806576, -10.32517699806576,
+		-10.32517699806576, -10.32517699806576, -10.32517699806576, -10.32517699806576, -8.073885199459266, -10.32517699806576, -6.244255456175801, -10.32517699806576,
+		-5.912378704725126, -9.072414029570393, -10.32517699806576, -7.20722709178752, -6.807679160707445, -10.32517699806576, -10.32517699806576, -10.32517699806576,
+		-10.32517699806576, -10.32517699806576, -10.32517699806576, -10.142855441271806, -10.32517699806576, -10.32517699806576, -10.32517699806576, -10.32517699806576,
+		-10.32517699806576, -10.32517699806576, -8.18511083456949, -10.32517699806576, -10.32517699806576, -10.32517699806576, -10.554804417891335, -6.954756177484015,
+		-5.774001663260086, -2.262731730673529, -10.554804417891335, -2.1756786054341886, -3.526337063746935, -7.002317588682954, -4.328663083655471, -3.134644762029993,
+		-10.554804417891335, -2.215518540390633, -1.7904386973615272, -5.16163152033062, -5.312528442226924, -10.554804417891335, -10.554804417891335, -4.444446340842943,
+		-2.18321520033389, -10.554804417891335, -4.098034762319172, -3.549559517631941, -3.3908577335487884, -10.554804417891335, -3.242117506407178, -10.554804417891335,
+		-2.8259485940387923, -4.820492738200311, -5.5243664964989, -4.479688298287742, -10.554804417891335, -5.107205348627544, -10.554804417891335, -10.554804417891335,
+		-10.554804417891335, -10.554804417891335, -10.554804417891335, -9.63851368601718, -3.9733334491429213, -3.143489851135253, -6.45216105285454, -10.292440153423845,
+		-5.668976774388428, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -6.685688913474466,
+		-10.554804417891335, -10.554804417891335, -10.554804417891335, -7.763639310078619, -9.391653608085655, -10.554804417891335, -10.554804417891335, -10.554804417891335,
+		-10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335,
+		-10.554804417891335, -7.7514440369848, -10.554804417891335, -6.43902457494917, -10.554804417891335, -7.887576211309381, -8.98618849997749, -10.554804417891335,
+		-9.168510056771446, -7.794794477858415, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335,
+		-10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -10.554804417891335, -8.652696891494415,
+		-10.554804417891335, -10.554804417891335, -10.554804417891335, -6.404236230398585, -6.404236230398585, -1.4960028699204098, -6.30892605059426, -6.404236230398585,
+		-6.30892605059426, -1.3403761750650312, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -1.1619602547341727, -6.404236230398585,
+		-6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585,
+		-6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585,
+		-5.48794549852443, -6.404236230398585, -2.9963943060177605, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585,
+		-6.404236230398585, -6.404236230398585, -6.404236230398585, -6.141871965931093, -6.404236230398585, -6.22191467360463, -6.404236230398585, -6.404236230398585,
+		-6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585,
+		-6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585,
+		-6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585,
+		-6.404236230398585, -6.404236230398585, -6.141871965931093, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585,
+		-6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585,
+		-6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585, -6.404236230398585,
+		-9.54526140610466, -1.9251447276387725, -1.710315020780079, -4.0166262844944125, -9.54526140610466, -3.3698100228836614, -1.6641547639667549, -9.54526140610466,
+		-3.83417235626602, -2.365724804202418, -9.54526140610466, -3.2034921620657886, -8.903407519932264, -9.54526140610466, -9.54526140610466, -9.54526140610466,
+		-9.54526140610466, -3.3195155632720117, -8.903407519932264, -9.54526140610466, -7.8224948083635555, -9.54526140610466, -5.8563819519907225, -9.54526140610466,
+		-3.3108506803862876, -9.54526140610466, -9.139796297996496, -5.9701107173190655, -4.630403067402172, -2.9308039383599387, -7.7207121140536135, -2.1072897255645993,
+		-9.075257776858924, -9.54526140610466, -9.449951226300334, -9.208789169483445, -9.54526140610466, -8.480550669112231, -9.54526140610466, -9.014633155042489,
+		-6.529726505254488, -9.139796297996496, -6.279501995337608, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.449951226300334,
+		-9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.282897141637168, -9.139796297996496, -8.852114225544714,
+		-9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.449951226300334, -9.54526140610466,
+		-9.54526140610466, -9.54526140610466, -7.997698897388647, -8.803324061375282, -9.54526140610466, -8.351338937632224, -9.54526140610466, -8.852114225544714,
+		-6.36304956560805, -9.54526140610466, -8.669792668750759, -9.282897141637168, -9.449951226300334, -9.54526140610466, -9.54526140610466, -9.54526140610466,
+		-9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466, -9.54526140610466,
+		-9.54526140610466, -7.9766454881908135, -9.54526140610466, -9.54526140610466, -9.54526140610466, -10.704118243604723, -1.1778582577655206, -2.3470468570865397,
+		-1.6839461334487984, -10.704118243604723, -3.481406250035925, -2.3415224984676817, -10.704118243604723, -5.902559243611242, -4.028295021969876, -10.704118243604723,
+		-3.9252195867475907, -7.270131039119577, -8.98135164586362, -8.599984089334516, -10.704118243604723, -10.704118243604723, -9.962180898875346, -7.229051013376113,
+		-10.704118243604723, -9.828649506250823, -10.116331578702605, -3.7845332128690092, -10.704118243604723, -4.411623606525461, -10.704118243604723, -6.076208570647143,
+		-4.22422706767423, -4.199530713682067, -2.366099566120573, -10.704118243604723, -3.1726194542015205, -10.704118243604723, -10.6088080638004, -10.441753979137232,
+		-10.704118243604723, -10.704118243604723, -8.895829472425458, -8.362312437457398, -5.240710257191094, -4.527419718255752, -8.832316066703132, -4.31320666071414,
+		-10.704118243604723, -10.704118243604723, -10.367646006983511, -10.704118243604723, -10.704118243604723, -10.704118243604723, -10.116331578702605, -10.704118243604723,
+		-10.704118243604723, -10.704118243604723, -6.961698022562757, -8.48491475954973, -10.704118243604723, -10.6088080638004, -10.704118243604723, -10.6088080638004,
+		-10.704118243604723, -10.704118243604723, -10.234114614358989, -10.704118243604723, -10.704118243604723, -10.704118243604723, -10.704118243604723, -10.704118243604723,
+		-7.969750734185141, -10.704118243604723, -6.621508937601044, -10.704118243604723, -5.945368969688332, -10.704118243604723, -10.704118243604723, -7.3612564389555315,
+		-6.6454008590257745, -10.704118243604723, -10.704118243604723, -10.704118243604723, -10.704118243604723, -10.704118243604723, -10.704118243604723, -10.29865313549656,
+		-10.704118243604723, -10.704118243604723, -10.704118243604723, -10.367646006983511, -10.704118243604723, -10.704118243604723, -7.836219341560618, -10.6088080638004,
+		-10.704118243604723, -10.704118243604723, -5.683093740597644, -5.837244420424902, -1.5525090933603996, -4.179016343821369, -6.019565977218857, -2.279518236530521,
+		-1.7195631780235658, -6.019565977218857, -6.019565977218857, -6.019565977218857, -5.757201712751366, -2.400572650569087, -6.019565977218857, -6.019565977218857,
+		-6.019565977218857, -4.031691629064511, -6.019565977218857, -6.019565977218857, -6.019565977218857, -5.231108616854587, -5.924255797414532, -6.019565977218857,
+		-6.019565977218857, -5.924255797414532, -4.117458450821936, -5.103275245344702, -6.019565977218857, -5.924255797414532, -6.019565977218857, -3.844814255734696,
+		-6.019565977218857, -3.22840086940614, -6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857,
+		-6.019565977218857, -5.924255797414532, -2.975043539495434, -5.614100869110692, -6.019565977218857, -4.766803008723489, -5.757201712751366, -6.019565977218857,
+		-6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857, -4.261708059666483, -6.019565977218857, -6.019565977218857, -6.019565977218857,
+		-6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857, -5.837244420424902, -5.488937726156687, -6.019565977218857, -6.019565977218857,
+		-4.825643508746422, -5.231108616854587, -6.019565977218857, -4.537961436294641, -6.019565977218857, -5.924255797414532, -6.019565977218857, -5.144097239864957,
+		-6.019565977218857, -5.924255797414532, -6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857,
+		-6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857, -5.924255797414532, -6.019565977218857, -6.019565977218857, -6.019565977218857,
+		-6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857, -6.019565977218857, -5.837244420424902, -6.019565977218857, -9.53274266090615,
+		-8.577231215878713, -3.2821884357104896, -3.43466837873991, -9.53274266090615, -1.9111066349771613, -6.095534841720962, -9.437432481101826, -3.243769480528652,
+		-1.9677783081696971, -9.53274266090615, -9.437432481101826, -2.141142486489797, -3.73911948225016, -3.1907973140227632, -9.53274266090615, -9.53274266090615,
+		-6.225855958715236, -5.016403688624675, -9.53274266090615, -4.027411124973788, -7.250360275229624, -1.9729747447226231, -9.53274266090615, -2.2660549879440657,
+		-9.53274266090615, -3.41486575144373, -6.483469620424129, -7.369419635245612, -3.7315310409184033, -9.53274266090615, -3.690358336812321, -9.53274266090615,
+		-9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.270378396438659, -3.1020559088918707, -9.350421104112195, -6.118300052493974,
+		-9.196270424284938, -5.771542545212588, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615,
+		-7.3135391768511555, -9.53274266090615, -9.53274266090615, -9.53274266090615, -7.023143398527779, -9.062739031660415, -9.53274266090615, -9.53274266090615,
+		-9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615,
+		-9.53274266090615, -9.53274266090615, -5.264444791560612, -9.53274266090615, -8.36959185110047, -9.53274266090615, -6.342266310559648, -8.944955996004031,
+		-9.53274266090615, -8.74428530054188, -6.983297489980579, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615,
+		-9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615, -9.53274266090615,
+		-9.196270424284938, -9.53274266090615, -9.53274266090615, -9.53274266090615, -10.500473441198585, -6.90316118061014, -2.5438564988128953, -1.60436447690772,
+		-10.500473441198585, -7.261794989034205, -2.9618187951360304, -6.295780821807619, -3.1030957461015607, -2.313146355018625, -10.500473441198585, -4.92869970946275,
+		-4.679204271086718, -6.870813346744621, -2.1216921039368897, -10.500473441198585, -10.500473441198585, -4.925661347839345, -4.837165799108103, -10.500473441198585,
+		-1.7508994931156565, -5.358809884695925, -3.121465313570281, -10.500473441198585, -4.660122523760365, -10.500473441198585, -6.035715408927239, -4.123576439732675,
+		-4.569289824975534, -3.369254662091319, -10.500473441198585, -3.6291746992270637, -10.500473441198585, -10.318151884404632, -10.164001204577373, -10.500473441198585,
+		-10.500473441198585, -8.408609379520193, -7.772620612800196, -7.34773741883493, -4.420082684896202, -8.384217926396033, -4.012180779968467, -10.500473441198585,
+		-10.500473441198585, -10.318151884404632, -10.500473441198585, -10.500473441198585, -10.500473441198585, -7.46072428222782, -10.500473441198585, -10.500473441198585,
+		-10.500473441198585, -7.109326395389932, -6.821644322938151, -10.500473441198585, -10.40516326139426, -10.500473441198585, -10.40516326139426, -10.500473441198585,
+		-10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585, -8.814074487628357,
+		-10.500473441198585, -6.3214814049162005, -10.500473441198585, -5.111401711382085, -6.873469390802737, -10.500473441198585, -7.105965047687227, -6.953733754245772,
+		-10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585,
+		-10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585, -10.500473441198585, -7.59357238135121, -10.40516326139426, -10.500473441198585,
+		-10.500473441198585, -8.826675893826872, -8.56431162935938, -1.1442395313035265, -5.176017652533132, -8.826675893826872, -2.7914337243730016, -2.2536942948303653,
+		-4.930782270328609, -6.054087171587089, -4.633995430883909, -8.826675893826872, -2.2633847013954997, -6.811772873284607, -5.074821640551546, -8.826675893826872,
+		-8.826675893826872, -8.826675893826872, -8.731365714022546, -8.731365714022546, -8.826675893826872, -6.223986208382487, -6.246459064234546, -3.7237653236214445,
+		-8.826675893826872, -2.0772131938393277, -8.826675893826872, -6.047856621836454, -7.086209718986367, -2.3219387193098466, -3.3311483579911294, -8.826675893826872,
+		-2.6158750337476335, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.184822007654477, -8.644354337032917,
+		-7.728063605158762, -6.554550008317534, -8.644354337032917, -6.300947249518615, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872,
+		-8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.731365714022546, -8.826675893826872,
+		-8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872,
+		-8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872, -7.140276940256642, -8.826675893826872,
+		-8.421210785718706, -8.826675893826872, -8.826675893826872, -8.133528713266927, -8.56431162935938, -8.826675893826872, -8.826675893826872, -8.826675893826872,
+		-8.826675893826872, -8.826675893826872, -8.826675893826872, -8.644354337032917, -8.826675893826872, -8.826675893826872, -8.826675893826872, -8.826675893826872,
+		-8.826675893826872, -8.826675893826872, -8.356672264581135, -8.826675893826872, -8.826675893826872, -8.826675893826872, -9.155482838491464, -2.2320510801243185,
+		-2.044378635523296, -1.1605133157935859, -9.155482838491464, -2.6328019779697565, -2.986290603599754, -9.155482838491464, -9.155482838491464, -5.21002505697708,
+		-9.155482838491464, -3.652185891256888, -3.7573201369737106, -8.893118574023973, -4.646823552884215, -9.155482838491464, -9.155482838491464, -8.685479209245727,
+		-8.513628952319069, -9.155482838491464, -7.181401812469454, -9.155482838491464, -4.126352961759043, -9.155482838491464, -3.392488504203416, -9.155482838491464,
+		-6.1350579523471005, -3.6802753051466786, -5.908991846590289, -2.889041591429254, -9.155482838491464, -2.718051239965987, -9.155482838491464, -9.060172658687138,
+		-9.155482838491464, -9.155482838491464, -9.155482838491464, -7.961560370019028, -9.155482838491464, -7.931707406869347, -4.02204011513366, -7.487776017933387,
+		-3.749657264228141, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464,
+		-9.155482838491464, -9.155482838491464, -9.155482838491464, -6.421115329071879, -6.992159812830925, -9.155482838491464, -8.973161281697509, -9.155482838491464,
+		-9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464,
+		-9.155482838491464, -8.973161281697509, -9.155482838491464, -5.437044582135982, -9.155482838491464, -7.314933205093976, -9.155482838491464, -9.155482838491464,
+		-6.775936704361289, -6.316404374982849, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464,
+		-8.199971393464027, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -9.155482838491464, -7.469083884921234,
+		-9.155482838491464, -9.155482838491464, -9.155482838491464, -4.975046496065859, -4.505042866820124, -6.479123892842133, -2.622613597344246, -4.830465267254752,
+		-4.249109492682923, -6.479123892842133, -6.479123892842133, -4.654574600791087, -5.646214769907029, -5.690666532477863, -3.1944603274359293, -2.307818289483904,
+		-6.296802336048178, -5.603655155488233, -5.144122826109793, -4.9315613841261206, -6.216759628374642, -5.380511604174023, -4.054321167123838, -4.505042866820124,
+		-2.833673996655533, -5.092829531722242, -3.161308120119029, -3.725463180487871, -6.296802336048178, -2.103366871181847, -4.622825902476507, -5.380511604174023,
+		-5.414413155849704, -4.011024361370514, -3.4018116322957193, -4.196741507165607, -6.479123892842133, -6.479123892842133, -6.479123892842133, -6.479123892842133,
+		-6.479123892842133, -4.869685980408033, -6.383813713037808, -4.072178784523845, -6.383813713037808, -5.948495641779963, -5.48587211983185, -5.948495641779963,
+		-6.479123892842133, -5.255348461220017, -5.198190047380069, -4.910507974928288, -3.4884041611116863, -2.234923575075655, -6.479123892842133, -6.479123892842133,
+		-6.296802336048178, -5.837270006669739, -4.5190291087948635, -6.479123892842133, -6.479123892842133, -5.562833160967978, -3.383546284318426, -6.479123892842133,
+		-6.479123892842133, -4.638574259444646, -5.562833160967978, -6.479123892842133, -5.603655155488233, -6.479123892842133, -6.296802336048178, -6.479123892842133,
+		-5.948495641779963, -5.347721781351033, -6.383813713037808, -6.0736587847339685, -6.479123892842133, -6.479123892842133, -6.479123892842133, -6.14265165622092,
+		-6.479123892842133, -6.479123892842133, -6.479123892842133, -6.479123892842133, -6.383813713037808, -6.479123892842133, -6.479123892842133, -6.479123892842133,
+		-6.479123892842133, -6.479123892842133, -6.479123892842133, -6.479123892842133, -6.479123892842133, -6.479123892842133, -6.479123892842133, -6.479123892842133,
+		-6.5355315046537745, -6.5355315046537745, -2.0115713740912264, -5.470820767661346, -6.5355315046537745, -2.3642259012955456, -1.6155505788256497, -6.5355315046537745,
+		-6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -1.4959848670630989, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -4.443667442975381,
+		-6.273167240186283, -6.5355315046537745, -6.5355315046537745, -5.34160903618134, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.13006639654561,
+		-4.494311175794136, -6.5355315046537745, -6.5355315046537745, -6.273167240186283, -3.3956988871260267, -3.7261288092912768, -5.947744839751656, -2.2686351772335245,
+		-6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.273167240186283,
+		-4.830783412415349, -6.5355315046537745, -6.273167240186283, -5.372380694848093, -5.660062767299874, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745,
+		-6.5355315046537745, -6.5355315046537745, -5.505912087472616, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745,
+		-6.5355315046537745, -6.5355315046537745, -6.44022132484945, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -5.076916481954258, -4.53405150444365,
+		-6.5355315046537745, -5.076916481954258, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.273167240186283,
+		-6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745,
+		-6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745,
+		-6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -6.5355315046537745, -8.073714641109857, -3.6548740333132588, -1.2194656296508608,
+		-1.6607476081960864, -8.073714641109857, -6.368966548871431, -4.004687886872047, -8.073714641109857, -6.333248466269352, -6.9751023524417475, -8.073714641109857,
+		-5.771129548115812, -2.3739401878511948, -7.485927976207738, -6.942312529618756, -8.073714641109857, -8.073714641109857, -7.978404461305532, -6.368966548871431,
+		-8.073714641109857, -7.737242404488644, -7.285257280745586, -3.0290000333607385, -8.073714641109857, -3.9694197480345883, -8.073714641109857, -6.127804492054544,
+		-4.177821017611595, -4.685940279779842, -1.8307134681864472, -8.073714641109857, -3.9644814663940053, -8.073714641109857, -8.073714641109857, -8.073714641109857,
+		-8.073714641109857, -8.073714641109857, -7.380567460549911, -7.891393084315903, -5.50876528364832, -3.893192382646704, -7.668249533001693, -3.4102755469977897,
+		-8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857,
+		-8.073714641109857, -8.073714641109857, -6.019590907414311, -7.198245903755957, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857,
+		-8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857,
+		-8.073714641109857, -8.073714641109857, -5.276433306279704, -8.073714641109857, -6.687420279989967, -7.978404461305532, -8.073714641109857, -6.464276728675756,
+		-5.957459126307304, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -7.891393084315903,
+		-8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -8.073714641109857, -6.792780795647793, -8.073714641109857,
+		-8.073714641109857, -8.073714641109857, -9.36993904166287, -9.033466805041657, -2.492746068263372, -1.0416329128827515, -9.36993904166287, -2.558474422999019,
+		-1.9445215616557534, -9.274628861858545, -9.36993904166287, -3.3194982894545904, -9.36993904166287, -3.5220562230329118, -7.74069850193259, -8.034937974930529,
+		-9.187617484868914, -9.36993904166287, -9.36993904166287, -8.839310790600699, -2.930908210428088, -9.36993904166287, -9.274628861858545, -9.36993904166287,
+		-6.251989135384629, -9.36993904166287, -3.7748559839300095, -9.36993904166287, -9.107574777195378, -3.552827881699665, -6.139134645929395, -2.5197069489243984,
+		-9.36993904166287, -2.8028447599234, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -8.628001696933492,
+		-9.36993904166287, -7.934854516373547, -4.999226166889261, -8.839310790600699, -4.655017991715659, -9.36993904166287, -9.36993904166287, -9.36993904166287,
+		-9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -7.453016429480808,
+		-8.176016573190434, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.274628861858545, -9.36993904166287, -9.36993904166287, -9.36993904166287,
+		-9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -5.929520946847433,
+		-9.36993904166287, -7.888334500738654, -9.36993904166287, -9.36993904166287, -8.176016573190434, -7.911324018963352, -9.36993904166287, -9.36993904166287,
+		-9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287,
+		-9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -9.36993904166287, -4.31916290077309,
+		-4.094877144880104, -7.086053601898234, -2.626434812151214, -3.3592654838023996, -3.2753588485999754, -6.775898673594394, -7.181363781702559, -3.9826906641518773,
+		-3.1506692465569137, -5.592128576585978, -3.620317699098507, -1.288339307407829, -4.493516287917868, -4.79260099246746, -6.650735530640389, -5.846362714970218,
+		-6.650735530640389, -3.538528266090029, -7.181363781702559, -4.1856315081485675, -6.439426436973181, -2.9501600367632608, -4.962160297647564, -2.511342623394851,
+		-6.918999517235068, -2.3442885387316847, -5.114501022229582, -6.918999517235068, -6.188112008692275, -5.0651082669000065, -6.775898673594394, -5.406411430790885,
+		-7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -4.7217749398988484, -6.392906421338289, -5.089499720024166,
+		-7.181363781702559, -6.775898673594394, -5.65530747820751, -6.4882166011426134, -7.181363781702559, -7.181363781702559, -6.225852336675122, -6.348454658767454,
+		-6.392906421338289, -4.1560727059070235, -7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -5.2072827556805485, -6.999042224908604,
+		-7.181363781702559, -6.844891545081346, -3.7093973291521958, -7.181363781702559, -7.181363781702559, -4.378003400796024, -7.181363781702559, -7.181363781702559,
+		-6.711360152456823, -6.844891545081346, -6.918999517235068, -7.181363781702559, -6.650735530640389, -6.918999517235068, -6.999042224908604, -7.181363781702559,
+		-7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559,
+		-6.999042224908604, -7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559,
+		-7.181363781702559, -7.181363781702559, -7.181363781702559, -7.181363781702559, -9.964615577035932, -7.1024146961064645, -2.053584856212239, -0.6767032394648658,
+		-9.964615577035932, -3.7052253358418477, -3.271291908765984, -6.313957335742194, -8.801464767230252, -7.910491843340386, -9.964615577035932, -4.117021400347652,
+		-6.1338026270333295, -8.770693108563497, -5.471494894856464, -9.964615577035932, -9.964615577035932, -7.702852478562142, -7.16733424220578, -9.964615577035932,
+		-4.6103905785496, -5.632567312168292, -3.7866714629853324, -9.964615577035932, -4.6170319691849775, -9.964615577035932, -5.75545534038525, -3.2437924674054335,
+		-4.658826195649195, -2.5289415968616273, -9.964615577035932, -3.829916861842043, -9.964615577035932, -9.559150468927768, -9.782294020241977, -9.964615577035932,
+		-9.964615577035932, -8.077545928003552, -9.13170645410083, -7.479708927247932, -3.7378812988159003, -8.278216623465704, -3.3417462832035754, -9.964615577035932,
+		-9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932,
+		-9.964615577035932, -6.381096638579822, -6.737771582518555, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.782294020241977, -9.964615577035932,
+		-9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.782294020241977,
+		-9.964615577035932, -5.60918962415923, -9.964615577035932, -6.7065190390144505, -8.189663226124258, -9.964615577035932, -6.406414446564113, -6.654072563641908,
+		-9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932,
+		-9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -9.964615577035932, -7.801292551375394, -9.964615577035932, -9.964615577035932,
+		-9.964615577035932, -8.545877825276147, -8.545877825276147, -0.5165101333968387, -3.5252922003267226, -8.545877825276147, -6.284114726802356, -2.831155410581611,
+		-8.545877825276147, -8.545877825276147, -8.363556268482192, -8.545877825276147, -6.284114726802356, -4.5531969168305455, -8.545877825276147, -8.283513560808656,
+		-8.545877825276147, -8.545877825276147, -7.958091160374027, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.363556268482192, -5.199488680108986,
+		-8.545877825276147, -5.506128666305381, -8.545877825276147, -8.545877825276147, -6.233342401428933, -5.347204707725465, -1.7964151252886027, -8.545877825276147,
+		-2.5616896871389407, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.07587419603041, -8.545877825276147,
+		-8.450567645471821, -5.178581995289672, -8.545877825276147, -4.4923552575743, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147,
+		-8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -7.210876758543806, -8.140412717167981,
+		-8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147,
+		-8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -7.237545005625968, -8.545877825276147,
+		-4.9485655646877005, -8.545877825276147, -8.545877825276147, -7.803940480546769, -7.293114856780778, -8.545877825276147, -8.545877825276147, -8.545877825276147,
+		-8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147,
+		-8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -8.545877825276147, -10.395643986422996, -3.0047220916688433,
+		-2.202437303750532, -1.1984395138399548, -10.395643986422996, -8.508574337390616, -3.09227193538751, -9.990178878314833, -4.167724873941133, -2.1796369721590105,
+		-10.395643986422996, -3.3166280980063303, -6.319802895765456, -6.440561491534404, -8.103109229282452, -10.395643986422996, -10.395643986422996, -4.58151345459793,
+		-6.284770122249686, -10.395643986422996, -7.861947172465564, -8.025400244955136, -2.830835134529153, -10.395643986422996, -4.76014035252009, -10.395643986422996,
+		-4.665544203449422, -3.7955014153815196, -5.301280476796029, -2.81041369627521, -10.395643986422996, -3.2597974164027166, -10.395643986422996, -10.395643986422996,
+		-8.786206073988897, -10.395643986422996, -10.395643986422996, -7.499732048151217, -3.9287219593868157, -5.494823558333947, -3.6535891632185784, -7.556565522914383,
+		-3.161466806673148, -10.395643986422996, -10.395643986422996, -9.990178878314833, -10.395643986422996, -10.395643986422996, -10.395643986422996, -10.395643986422996,
+		-10.395643986422996, -10.395643986422996, -10.395643986422996, -6.37746078516646, -7.02490581224555, -10.395643986422996, -10.300333806618672, -10.395643986422996,
+		-9.925640357177262, -10.395643986422996, -10.395643986422996, -10.395643986422996, -10.395643986422996, -10.395643986422996, -10.395643986422996, -10.395643986422996,
+		-10.395643986422996, -10.059171749801784, -10.395643986422996, -6.075492755467202, -10.395643986422996, -6.37746078516646, -7.149152994521823, -10.395643986422996,
+		-6.148578337183232, -6.701776990798022, -10.395643986422996, -10.395643986422996, -10.395643986422996, -10.395643986422996, -10.395643986422996, -10.395643986422996,
+		-9.865015735360826, -10.395643986422996, -10.395643986422996, -10.395643986422996, -10.213322429629041, -10.395643986422996, -10.395643986422996, -6.090228454120956,
+		-8.209592709684904, -10.395643986422996, -10.395643986422996, -4.015080292655902, -2.0463120055245385, -2.3214287475683015, -3.5773849066428247, -6.032877671224531,
+		-6.865786794159636, -1.7424182300761404, -6.865786794159636, -3.5811232287534316, -1.9092557590565806, -6.865786794159636, -2.9087904230887585, -6.460321686051471,
+		-6.865786794159636, -6.865786794159636, -3.890257227923164, -6.770476614355311, -5.025237160762148, -6.865786794159636, -6.123849449430258, -6.865786794159636,
+		-6.460321686051471, -6.865786794159636, -4.83763854686735, -4.850883773617371, -5.407171771460119, -4.093198071919854, -4.248390961325557, -4.28556996456731,
+		-3.5407507734630443, -5.430702268870313, -3.4513441857474594, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636,
+		-6.865786794159636, -3.2602889489847504, -5.05749802298037, -4.87791244600529, -6.683465237365681, -5.9903180568057355, -4.919876645104322, -5.801076057167207,
+		-6.529314557538423, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636,
+		-6.865786794159636, -5.64201136253752, -5.836167376978477, -6.6034225296921445, -6.865786794159636, -5.801076057167207, -6.770476614355311, -6.865786794159636,
+		-6.865786794159636, -6.3957831649139, -5.217128168572254, -6.865786794159636, -4.978717145127256, -6.683465237365681, -6.865786794159636, -6.865786794159636,
+		-5.801076057167207, -6.865786794159636, -6.683465237365681, -5.407171771460119, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636,
+		-6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636,
+		-6.865786794159636, -6.865786794159636, -6.865786794159636, -6.865786794159636, -6.770476614355311, -6.865786794159636, -6.865786794159636, -6.865786794159636,
+		-9.887530919911866, -8.0792421487326, -1.8051595592647913, -2.207170539035068, -9.887530919911866, -5.679857672382762, -2.4951913283689655, -9.887530919911866,
+		-5.783236026836597, -3.880193023647594, -9.887530919911866, -3.8425256058758546, -6.544669115262674, -6.697054569565363, -6.906912284167923, -9.887530919911866,
+		-9.887530919911866, -4.981515674945713, -4.15840563758387, -9.887530919911866, -2.8686815934241423, -5.104214548540301, -3.9236945399213585, -9.887530919911866,
+		-2.072444930427085, -9.887530919911866, -5.081053877218739, -4.5057919452247575, -2.341243053643007, -2.2060628125302166, -9.887530919911866, -2.2985492312654774,
+		-9.887530919911866, -9.887530919911866, -9.792220740107542, -9.887530919911866, -9.887530919911866, -8.579198100261687, -5.63050077541267, -5.495553954384817,
+		-5.0464984102017905, -8.693608451439431, -4.350984364631579, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866,
+		-9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -7.078128224549369, -8.606597074449802, -9.887530919911866,
+		-9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866,
+		-9.887530919911866, -9.887530919911866, -9.887530919911866, -8.606597074449802, -9.887530919911866, -6.24994476018548, -9.887530919911866, -7.172836176090987,
+		-9.792220740107542, -9.887530919911866, -7.584945826917821, -7.453917564511417, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866,
+		-9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866, -9.887530919911866,
+		-9.887530919911866, -8.361474616416817, -9.887530919911866, -9.887530919911866, -9.887530919911866, -5.978041526775841, -6.719978871505218, -1.0072366554775456,
+		-4.774068722449905, -6.624668691700894, -6.719978871505218, -1.8632720597016996, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218,
+		-3.6380689017101755, -6.719978871505218, -6.719978871505218, -6.719978871505218, -3.1995180690162455, -6.719978871505218, -6.719978871505218, -6.719978871505218,
+		-2.8466966943935033, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.314513763397054, -6.719978871505218, -6.719978871505218, -6.026831690945273,
+		-5.978041526775841, -5.090738331774938, -2.267959865011302, -6.719978871505218, -2.243779066814087, -6.719978871505218, -6.719978871505218, -6.719978871505218,
+		-6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -4.691830624212933, -6.719978871505218, -6.457614607037728,
+		-4.705075850962954, -6.314513763397054, -6.719978871505218, -6.624668691700894, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.026831690945273,
+		-6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.624668691700894, -6.719978871505218, -6.719978871505218, -6.719978871505218,
+		-6.249975242259483, -6.719978871505218, -6.719978871505218, -6.537657314711264, -5.333684510385328, -6.719978871505218, -6.026831690945273, -6.719978871505218,
+		-6.719978871505218, -6.719978871505218, -6.537657314711264, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218,
+		-6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218,
+		-6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.719978871505218, -6.624668691700894, -6.719978871505218,
+		-6.719978871505218, -6.719978871505218, -9.407213867189949, -9.070741630568737, -1.3555224535760393, -2.105189252860382, -9.407213867189949, -7.232462145705788,
+		-2.3116521011832876, -9.311903687385623, -7.520144218157569, -7.353090133494403, -9.407213867189949, -3.4964172231494217, -5.664793646147983, -3.6690299658164154,
+		-9.311903687385623, -9.407213867189949, -9.407213867189949, -8.876585616127779, -6.4215319294894595, -9.407213867189949, -8.81942720228783, -9.224892310395994,
+		-3.5250063060189163, -9.407213867189949, -6.204467424251631, -9.407213867189949, -3.621851405541325, -4.6675127882442515, -3.4717907109655917, -2.4915896298291167,
+		-9.407213867189949, -1.846092277659711, -9.407213867189949, -9.407213867189949, -8.714066686630003, -9.407213867189949, -9.407213867189949, -7.8811575636949,
+		-2.676315105570708, -8.61875650682568, -3.950611521676199, -8.126280021727885, -3.9924476879986655, -9.407213867189949, -9.407213867189949, -9.407213867189949,
+		-9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -6.746954329924087,
+		-7.566664233792462, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.070741630568737, -9.407213867189949, -9.407213867189949, -9.407213867189949,
+		-9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -6.556507365686215,
+		-9.407213867189949, -8.183438435567833, -9.407213867189949, -9.407213867189949, -6.897614604811577, -6.7058526542385355, -9.407213867189949, -9.407213867189949,
+		-9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949, -9.407213867189949,
+		-9.407213867189949, -9.224892310395994, -9.407213867189949, -7.290958352387396, -9.407213867189949, -9.407213867189949, -9.407213867189949, -3.775585940844134,
+		-3.548001825145054, -4.039502319534884, -2.5818473916929428, -4.539992310132719, -4.014360119593894, -3.56615704539263, -6.399917435414348, -3.4513255249096852,
+		-2.6608092068015314, -6.1393436817206455, -4.952724892087861, -4.612819979292105, -3.8189961323867596, -4.5943009315248675, -5.87891125171624, -5.031218836184838,
+		-6.1215812253808055, -3.794647103376473, -4.263963683471171, -3.9146232638233975, -5.392129279890424, -3.1443285111016515, -4.909891792093725, -4.294083082378106,
+		-5.073521215875527, -3.998645207106248, -1.6492585612823754, -5.827756088415311, -3.6514546259045253, -4.809259417631845, -2.9278065472459116, -5.553949362457146,
+		-6.341209834587571, -5.959414470565311, -5.935744726479406, -7.858532458113865, -9.467970370547965, -3.7043478955357476, -3.4663079984635377, -9.285648813754012,
+		-2.7623312756879628, -9.467970370547965, -4.686329041444095, -7.175435613407421, -6.611500164327483, -5.069824353994201, -5.58847055682538, -6.676805262735249,
+		-9.285648813754012, -5.542044459950828, -8.032885845258644, -7.98636582962375, -9.467970370547965, -9.467970370547965, -5.342450190857416, -5.944555356161561,
+		-7.941914067052917, -9.467970370547965, -5.128068662174755, -7.38852882886813, -9.467970370547965, -6.367878081669732, -5.690622268446421, -6.462287766140807,
+		-7.079207581312867, -6.409263297832586, -8.880183705645846, -9.467970370547965, -8.72603302581859, -8.369358081879856, -8.474718597537683, -6.507865274637126,
+		-8.032885845258644, -9.467970370547965, -9.467970370547965, -8.679513010183696, -9.467970370547965, -8.826116484375572, -7.941914067052917, -7.627420737150478,
+		-8.474718597537683, -9.467970370547965, -8.880183705645846, -9.467970370547965, -9.467970370547965, -9.467970370547965, -9.467970370547965, -9.467970370547965,
+		-5.165257542593809, -4.989497837605832, -9.467970370547965, -7.466490370337842, -9.09522128485596, -8.0656018676748, -3.1244697793455183, -0.5897363685718822,
+		-9.09522128485596, -5.9553886673282115, -2.444942236268537, -9.09522128485596, -5.734845897714059, -4.392017358596502, -9.09522128485596, -7.238923294490332,
+		-5.589663887869561, -6.21042057200925, -7.286932513676693, -9.09522128485596, -9.09522128485596, -7.526605366942113, -6.569492640547703, -9.09522128485596,
+		-6.31021004261762, -8.689756176747794, -3.302207676471815, -9.09522128485596, -4.865472085627655, -9.09522128485596, -4.520510306352576, -3.002103084021477,
+		-9.09522128485596, -3.900599154646687, -9.09522128485596, -4.004543283086167, -9.09522128485596, -9.09522128485596, -8.912899728062005, -9.09522128485596,
+		-9.09522128485596, -7.320268933944285, -4.8185551658399035, -6.492531599411575, -3.2068974966674904, -7.56916498136091, -2.8116469819690444, -9.09522128485596,
+		-9.09522128485596, -8.912899728062005, -9.09522128485596, -9.09522128485596, -9.09522128485596, -7.814287439393895, -9.09522128485596, -9.09522128485596,
+		-9.09522128485596, -5.714226610511323, -6.463332444719313, -9.09522128485596, -9.09522128485596, -9.09522128485596, -8.912899728062005, -9.09522128485596,
+		-9.09522128485596, -9.09522128485596, -9.09522128485596, -9.09522128485596, -9.09522128485596, -9.09522128485596, -9.09522128485596, -7.78688846520578,
+		-9.09522128485596, -5.033055620998093, -9.09522128485596, -5.408844960960141, -9.09522128485596, -9.09522128485596, -6.065087584584635, -5.704074239047305,
+		-9.09522128485596, -9.09522128485596, -9.09522128485596, -9.09522128485596, -9.09522128485596, -9.09522128485596, -8.999911105051634, -9.09522128485596,
+		-9.09522128485596, -9.09522128485596, -8.912899728062005, -9.09522128485596, -9.09522128485596, -6.499966577899093, -8.999911105051634, -9.09522128485596,
+		-8.999911105051634, -10.46137041124524, -9.180436565783173, -3.129328269914349, -6.176405489414488, -10.46137041124524, -3.377563188482742, -2.6299913530221546,
+		-10.366060231440914, -2.7487029883723357, -2.1135193746749015, -10.46137041124524, -6.474239933330287, -1.2987507754804675, -4.684027733481009, -3.6597537283247688,
+		-10.46137041124524, -10.46137041124524, -5.196609828226045, -3.966709267535757, -10.46137041124524, -3.1835009014578626, -3.806316235819637, -2.0188576635055884,
+		-10.46137041124524, -3.0803044772598698, -10.46137041124524, -3.1464847448358757, -8.544447799063178, -10.366060231440914, -9.075076050125348, -10.46137041124524,
+		-3.6796319728465887, -10.46137041124524, -10.46137041124524, -10.46137041124524, -10.46137041124524, -10.46137041124524, -10.366060231440914, -4.895701238718671,
+		-10.055905303137074, -7.794142204663284, -10.055905303137074, -7.094074581258765, -10.46137041124524, -10.46137041124524, -10.366060231440914, -10.46137041124524,
+		-10.46137041124524, -10.46137041124524, -6.202924838342711, -10.46137041124524, -10.46137041124524, -10.46137041124524, -9.545079679371083, -10.366060231440914,
+		-10.46137041124524, -10.46137041124524, -10.46137041124524, -10.366060231440914, -10.46137041124524, -10.46137041124524, -10.46137041124524, -10.46137041124524,
+		-10.46137041124524, -10.46137041124524, -10.46137041124524, -10.46137041124524, -5.472299295064824, -10.46137041124524, -7.543599679160959, -10.46137041124524,
+		-8.036567685526943, -7.851300618503232, -10.46137041124524, -10.279048854451284, -9.719433066515862, -10.46137041124524, -10.46137041124524, -10.46137041124524,
+		-10.46137041124524, -10.46137041124524, -10.46137041124524, -10.366060231440914, -10.366060231440914, -10.46137041124524, -10.46137041124524, -10.46137041124524,
+		-10.46137041124524, -10.46137041124524, -9.39665967425281, -10.46137041124524, -10.46137041124524, -10.46137041124524, -3.9479861560378233, -2.4603835964650544,
+		-4.3497815352570255, -5.053978552003556, -6.55805594877983, -4.016453955315284, -0.9738080428188186, -6.55805594877983, -6.55805594877983, -6.55805594877983,
+		-6.55805594877983, -4.216250142632503, -6.55805594877983, -6.55805594877983, -6.55805594877983, -4.441800433977278, -6.55805594877983, -6.55805594877983,
+		-6.375734391985875, -4.255470855785784, -6.55805594877983, -6.55805594877983, -6.55805594877983, -5.76959858841556, -3.0985896589936988, -2.680624388121303,
+		-6.55805594877983, -6.375734391985875, -4.9894400308659845, -3.5279222485085064, -6.221583712158617, -2.1272391499365164, -6.221583712158617, -6.55805594877983,
+		-6.55805594877983, -6.55805594877983, -6.55805594877983, -6.375734391985875, -6.55805594877983, -6.55805594877983, -4.6265345371766164, -6.55805594877983,
+		-6.4627457689755055, -4.766296479551775, -5.334280517157715, -6.55805594877983, -6.55805594877983, -6.55805594877983, -6.55805594877983, -6.55805594877983,
+		-5.6025445037523935, -6.55805594877983, -6.55805594877983, -6.55805594877983, -6.55805594877983, -6.375734391985875, -6.55805594877983, -6.55805594877983,
+		-6.4627457689755055, -6.55805594877983, -6.55805594877983, -6.55805594877983, -4.701757958414204, -4.040359476168839, -6.55805594877983, -5.3641334803073955,
+		-5.45944366011172, -5.68258721142593, -6.55805594877983, -6.4627457689755055, -6.55805594877983, -6.0880523195340945, -6.55805594877983, -6.55805594877983,
+		-6.55805594877983, -6.55805594877983, -6.375734391985875, -6.55805594877983, -6.55805594877983, -6.55805594877983, -6.55805594877983, -6.55805594877983,
+		-6.55805594877983, -6.55805594877983, -6.55805594877983, -6.55805594877983, -6.55805594877983, -6.55805594877983, -6.55805594877983, -6.55805594877983,
+		-6.55805594877983, -6.55805594877983, -6.55805594877983, -10.588607601708688, -7.3578032059752125, -6.040007767208991, -2.8564576055569324, -10.588607601708688,
+		-2.2978634186153863, -8.015995371501582, -7.047648277671374, -3.220078658859505, -1.9368660349701787, -10.588607601708688, -4.6034126676143, -1.596796443495969,
+		-3.9376819316955665, -3.9926904850560905, -10.588607601708688, -10.588607601708688, -4.493008289868698, -4.7901211915831885, -10.588607601708688, -3.0398935739121913,
+		-3.854253782145946, -2.35529040173528, -10.588607601708688, -2.515391682633979, -10.588607601708688, -3.75546811610734, -5.202279357837938, -3.629304060845974,
+		-3.228885380966966, -10.588607601708688, -9.946753715536293, -10.588607601708688, -10.588607601708688, -9.489995313040577, -10.588607601708688, -10.588607601708688,
+		-9.153523076419365, -3.9429966113904924, -4.693653954981549, -5.917649675182593, -9.595355828698404, -5.239597120845133, -10.588607601708688, -10.588607601708688,
+		-10.588607601708688, -10.588607601708688, -10.588607601708688, -10.588607601708688, -6.565043221547634, -10.588607601708688, -10.588607601708688, -10.588607601708688,
+		-7.767228715299474, -9.713138864354788, -10.588607601708688, -10.588607601708688, -10.588607601708688, -10.493297421904362, -10.588607601708688, -10.588607601708688,
+		-10.588607601708688, -10.588607601708688, -10.588607601708688, -10.588607601708688, -10.588607601708688, -10.588607601708688, -6.633525106820095, -10.588607601708688,
+		-7.365739755570949, -10.588607601708688, -7.047648277671374, -9.800150241344417, -10.588607601708688, -8.509166060028852, -8.642697452653374, -10.588607601708688,
+		-10.588607601708688, -10.588607601708688, -10.588607601708688, -10.588607601708688, -10.588607601708688, -10.588607601708688, -10.588607601708688, -10.588607601708688,
+		-10.588607601708688, -10.588607601708688, -10.588607601708688, -10.588607601708688, -7.3343646330031955, -10.588607601708688, -10.588607601708688, -10.588607601708688,
+		-6.206374293495088, -6.206374293495088, -1.428091489798352, -3.062222014822824, -6.206374293495088, -3.062222014822824, -1.6493444828349317, -6.206374293495088,
+		-6.206374293495088, -6.206374293495088, -6.206374293495088, -2.856470206220483, -5.675746042432918, -6.206374293495088, -6.206374293495088, -3.7553691953827695,
+		-6.206374293495088, -6.206374293495088, -6.206374293495088, -4.925440448033024, -6.206374293495088, -6.111064113690763, -6.206374293495088, -5.14166355650266,
+		-6.206374293495088, -6.206374293495088, -3.5601994961109655, -5.213122520484805, -6.111064113690763, -2.5847035890746017, -6.206374293495088, -2.9368053543113692,
+		-5.6185876285929695, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -5.736370664249352,
+		-5.043223483689407, -6.206374293495088, -5.4644369487657105, -3.704938341755877, -6.206374293495088, -6.024052736701133, -6.206374293495088, -6.111064113690763,
+		-5.17675487631393, -6.206374293495088, -5.736370664249352, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.111064113690763, -5.944010029027597,
+		-6.206374293495088, -6.206374293495088, -5.869902056873875, -6.206374293495088, -6.206374293495088, -6.206374293495088, -5.8009091853869235, -4.795387319784826,
+		-6.206374293495088, -5.012451825022653, -6.206374293495088, -6.206374293495088, -6.206374293495088, -5.8009091853869235, -6.206374293495088, -5.869902056873875,
+		-6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088,
+		-6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088, -6.206374293495088,
+		-6.206374293495088, -6.111064113690763, -6.206374293495088, -6.206374293495088, -6.206374293495088, -4.203946072137182, -3.688132906860152, -4.366465001634956,
+		-5.5902404332570725, -4.4270896234513915, -5.120236804011337, -3.8323825157046985, -5.494930253452747, -3.922533612698996, -3.2977056761165278, -5.407918876463118,
+		-5.407918876463118, -4.596988660246788, -4.4270896234513915, -5.120236804011337, -5.184775325148908, -4.634728988229636, -5.494930253452747, -4.525529696264644,
+		-3.7819516620778066, -5.120236804011337, -5.253768196635859, -3.922533612698996, -4.309306587795008, -5.120236804011337, -4.108635892332857, -4.948386547084677,
+		-5.5902404332570725, -5.5902404332570725, -3.798480964029017, -4.8017830728928015, -3.0970349806543767, -5.002453768354953, -5.5902404332570725, -5.407918876463118,
+		-2.7166757936772887, -3.4861062789868646, -5.327876168789581, -4.281907613606894, -3.415488711772911, -5.5902404332570725, -4.8017830728928015, -5.5902404332570725,
+		-5.002453768354953, -5.407918876463118, -5.253768196635859, -5.184775325148908, -2.7868800523505373, -5.407918876463118, -5.5902404332570725, -4.8017830728928015,
+		-5.253768196635859, -5.120236804011337, -5.5902404332570725, -5.5902404332570725, -4.203946072137182, -5.5902404332570725, -4.525529696264644, -5.5902404332570725,
+		-4.848303088527695, -3.072543960646081, -5.494930253452747, -5.184775325148908, -5.327876168789581, -5.059612182194901, -5.5902404332570725, -4.948386547084677,
+		-5.494930253452747, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.494930253452747, -5.494930253452747, -5.120236804011337, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.407918876463118, -3.381966019734268, -3.5233776737840965, -5.5902404332570725, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.407918876463118, -3.7031707842246924, -5.494930253452747,
+		-5.5902404332570725, -5.5902404332570725, -3.24738852374901, -5.119190700650601, -5.0238805208462765, -2.9909589948013333, -3.8954152690284856, -5.0238805208462765,
+		-5.119190700650601, -5.119190700650601, -5.0238805208462765, -4.936869143856646, -4.1636792556231645, -5.119190700650601, -5.119190700650601, -4.936869143856646,
+		-5.0238805208462765, -4.477336814478207, -4.588562449588431, -5.119190700650601, -4.85682643618311, -3.9560398908449206, -4.936869143856646, -5.119190700650601,
+		-4.713725592542437, -3.593134397155552, -5.0238805208462765, -4.531404035748482, -5.0238805208462765, -4.85682643618311, -5.119190700650601, -4.85682643618311,
+		-3.3613327830982276, -4.936869143856646, -4.089571283469443, -5.119190700650601, -5.119190700650601, -4.782718464029388, -5.119190700650601, -5.119190700650601,
+		-5.0238805208462765, -5.119190700650601, -4.531404035748482, -5.119190700650601, -4.020578411982491, -4.2862815777154974, -5.119190700650601, -5.119190700650601,
+		-4.713725592542437, -4.588562449588431, -4.713725592542437, -5.119190700650601, -4.202899968776446, -5.0238805208462765, -5.119190700650601, -5.119190700650601,
+		-5.119190700650601, -3.987788589159501, -5.119190700650601, -5.119190700650601, -5.119190700650601, -4.6491870714048655, -5.0238805208462765, -5.119190700650601,
+		-4.243721963296701, -4.377253355921224, -5.119190700650601, -5.0238805208462765, -5.119190700650601, -5.119190700650601, -5.119190700650601, -1.8159737273486498,
+		-5.119190700650601, -5.119190700650601, -5.119190700650601, -5.119190700650601, -5.0238805208462765, -5.119190700650601, -5.119190700650601, -5.119190700650601,
+		-5.119190700650601, -5.119190700650601, -5.119190700650601, -5.0238805208462765, -5.119190700650601, -5.119190700650601, -5.119190700650601, -5.119190700650601,
+		-5.119190700650601, -5.119190700650601, -5.119190700650601, -5.119190700650601, -5.119190700650601, -5.119190700650601, -5.119190700650601, -6.477280007869805,
+		-6.477280007869805, -6.477280007869805, -3.7300090936143135, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.477280007869805,
+		-6.38196982806548, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.38196982806548,
+		-6.477280007869805, -5.345877896378704, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.2149157434023135, -6.477280007869805, -6.477280007869805,
+		-6.477280007869805, -6.477280007869805, -2.992967719497143, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.38196982806548, -6.477280007869805,
+		-6.007276378624069, -6.477280007869805, -3.1065418336923583, -3.1851537212620116, -4.107036266401945, -6.477280007869805, -6.477280007869805, -3.170393305678891,
+		-6.477280007869805, -3.3549150833824477, -6.477280007869805, -6.477280007869805, -6.294958451075851, -6.477280007869805, -6.477280007869805, -6.477280007869805,
+		-6.477280007869805, -6.477280007869805, -2.4790793062006067, -2.5395892556930812, -6.477280007869805, -6.140807771248592, -6.477280007869805, -6.294958451075851,
+		-2.8476199134158398, -5.412569270877377, -6.477280007869805, -2.936320683832491, -2.7491798406025874, -6.477280007869805, -6.477280007869805, -6.477280007869805,
+		-6.477280007869805, -6.477280007869805, -6.477280007869805, -6.477280007869805, -4.22598820926331, -6.477280007869805, -6.477280007869805, -6.477280007869805,
+		-1.7091409936035737, -5.521768562842369, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.477280007869805, -3.223037039164313, -3.2153446935411574,
+		-6.477280007869805, -5.4840282348595215, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.477280007869805, -6.477280007869805,
+		-6.294958451075851, -6.477280007869805, -6.477280007869805, -6.477280007869805, -5.743644008081332, -5.743644008081332, -5.743644008081332, -3.1558799728536235,
+		-5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.561322451287377, -5.743644008081332, -5.743644008081332,
+		-5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -4.955186647717062, -5.743644008081332,
+		-5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -2.362649333736696,
+		-5.648333828277007, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -3.028949264260453,
+		-3.568892286597171, -3.783549224034062, -5.743644008081332, -5.743644008081332, -2.989983295727069, -5.648333828277007, -2.7630253723373888, -5.743644008081332,
+		-5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -3.1117551679446858,
+		-3.217915363773076, -5.743644008081332, -5.4812797436138405, -5.743644008081332, -5.743644008081332, -3.6895202743857856, -5.050496827521386, -5.743644008081332,
+		-2.9104306640251156, -2.6345830492203377, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332,
+		-5.743644008081332, -3.715495760789046, -5.743644008081332, -5.743644008081332, -5.743644008081332, -3.318841282363037, -4.8681752707274315, -5.561322451287377,
+		-5.743644008081332, -5.743644008081332, -5.743644008081332, -3.0764158014993765, -3.062622479367041, -5.743644008081332, -5.648333828277007, -5.743644008081332,
+		-5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332, -5.743644008081332,
+		-5.743644008081332, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -1.3960505360652553, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -3.210694299126898, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.120236804011337, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725,
+		-5.5902404332570725, -1.5127029893513526, -5.494930253452747, -2.1059281448844103, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.059612182194901,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -3.7819516620778066, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -4.8017830728928015, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -4.396317964784638, -5.494930253452747, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725,
+		-5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -5.5902404332570725, -9.114897183326574, -3.642626509655099,
+		-1.7132996856188591, -3.138038788607954, -9.114897183326574, -1.932925082846226, -2.07646458070282, -9.114897183326574, -6.447668976744619, -3.342833201253967,
+		-9.114897183326574, -3.162693350171894, -7.447190362768498, -7.656282160627057, -7.951746373520892, -9.114897183326574, -9.114897183326574, -7.779896116594234,
+		-6.874187494050616, -9.114897183326574, -8.527110518424454, -9.114897183326574, -3.7962871135107745, -9.114897183326574, -2.3260380592882925, -9.114897183326574,
+		-6.323732075513857, -5.603351744495553, -4.942049559616529, -2.926633060243984, -9.114897183326574, -2.131663443168531, -9.114897183326574, -9.114897183326574,
+		-9.114897183326574, -9.114897183326574, -9.114897183326574, -8.085277766145415, -2.7144736388561714, -7.306608412147308, -4.729127562373858, -8.77842494670536,
+		-4.974942109852421, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574,
+		-9.114897183326574, -9.114897183326574, -9.114897183326574, -7.5256619782099925, -7.588840879831524, -9.114897183326574, -9.114897183326574, -9.114897183326574,
+		-9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574,
+		-9.114897183326574, -9.114897183326574, -9.114897183326574, -7.02303312164818, -9.114897183326574, -7.7039102096163115, -9.114897183326574, -9.114897183326574,
+		-7.951746373520892, -8.016284894658464, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574,
+		-9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -9.114897183326574, -8.852532918859083,
+		-9.114897183326574, -9.114897183326574, -9.114897183326574, -9.164359258726359, -1.7348384159398973, -1.9501492219691579, -2.3265973515647573, -9.164359258726359,
+		-4.551220903089091, -2.5182285178767136, -9.164359258726359, -7.883425413264295, -5.676984180823152, -9.164359258726359, -7.7533722850160975, -3.175397841836496,
+		-7.856026439076181, -9.164359258726359, -9.164359258726359, -9.164359258726359, -6.989607537242199, -6.892233373217023, -9.164359258726359, -5.307848963228472,
+		-9.164359258726359, -4.302997667377858, -9.164359258726359, -5.44107837789509, -9.164359258726359, -8.633731007664188, -4.821853382214761, -7.036127552877091,
+		-1.7617248278079192, -9.164359258726359, -1.5894414961562706, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359,
+		-8.171107485716076, -8.57657259382424, -7.459611166487934, -4.815372478130678, -8.422421913996983, -4.236381198311113, -9.164359258726359, -9.164359258726359,
+		-9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359,
+		-6.794115517258499, -8.032957147235258, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.069049078922035, -9.164359258726359, -9.164359258726359,
+		-9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359,
+		-6.532470418589713, -9.164359258726359, -6.483337730012068, -9.164359258726359, -9.164359258726359, -7.441592660985256, -6.7484454804253104, -9.164359258726359,
+		-9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359,
+		-9.164359258726359, -9.164359258726359, -9.164359258726359, -9.164359258726359, -8.633731007664188, -9.164359258726359, -9.164359258726359, -9.164359258726359,
+		-7.999712135983405, -8.430495052075859, -8.69285931654335, -0.6354501528455894, -7.9509219718139725, -8.597549136739026, -7.411925471081286, -8.69285931654335,
+		-7.737347871515913, -8.105072651641231, -7.859950193608246, -8.69285931654335, -7.737347871515913, -8.69285931654335, -7.56145720505225, -6.718778290521341,
+		-7.469083884921234, -8.69285931654335, -8.69285931654335, -7.56145720505225, -8.69285931654335, -8.69285931654335, -8.69285931654335, -7.306564955423459,
+		-8.597549136739026, -7.33188276340775, -8.69285931654335, -1.3431784324502745, -8.69285931654335, -8.356387079922138, -7.440096348047982, -8.356387079922138,
+		-8.287394208435186, -8.69285931654335, -5.397022450539021, -7.90440195617908, -8.222855687297615, -5.764335792682809, -8.222855687297615, -8.69285931654335,
+		-2.6159053834199537, -2.6749962955808373, -6.127909959081814, -8.356387079922138, -7.384526496893171, -7.594247027875241, -7.90440195617908, -7.33188276340775,
+		-8.510537759749395, -8.69285931654335, -7.663239899362192, -8.16223106548118, -8.105072651641231, -7.90440195617908, -8.69285931654335, -8.105072651641231,
+		-8.051005430370955, -7.999712135983405, -6.112642486951025, -8.430495052075859, -8.105072651641231, -8.430495052075859, -7.90440195617908, -8.597549136739026,
+		-7.9509219718139725, -8.597549136739026, -8.356387079922138, -8.356387079922138, -7.90440195617908, -5.579344007332976, -8.69285931654335, -5.442484824615779,
+		-8.69285931654335, -8.051005430370955, -8.051005430370955, -7.737347871515913, -8.510537759749395, -8.69285931654335, -8.69285931654335, -8.222855687297615,
+		-7.999712135983405, -8.510537759749395, -8.69285931654335, -8.510537759749395, -8.69285931654335, -8.69285931654335, -8.69285931654335, -8.69285931654335,
+		-8.69285931654335, -4.685526131310879, -4.325946319679517, -8.69285931654335, -7.440096348047982, -3.1132564478291975, -5.7276617484461445, -6.4673289446409825,
+		-1.2399195475159968, -4.820503500135262, -5.900933469720181, -5.970892058327092, -7.20926628937036, -5.822971928250469, -4.186405348428116, -4.834360534796688,
+		-6.621479624468241, -6.3131782648137245, -5.069200125874089, -6.253754844342923, -5.609878712789761, -3.988392461839486, -7.43240984068457, -5.985490857748244,
+		-3.3559322802908933, -5.942318685883035, -7.26055958375791, -5.32219664033798, -4.33006783207232, -6.11065400070225, -3.7529496084871248, -5.762347306434034,
+		-1.6844111384400977, -6.077864177879259, -5.314649434702597, -4.73693842178922, -4.632844530746586, -4.528244760656069, -7.9024134699303055, -7.720091913136351,
+		-7.720091913136351, -7.80710329012598, -6.708491001457871, -5.835550710457329, -5.270524629793659, -6.771011358439204, -7.9024134699303055, -7.9024134699303055,
+		-2.642317316202466, -3.338065278462469, -5.762347306434034, -2.9038507570683185, -5.2421539326644435, -5.589878046083091, -7.9024134699303055, -5.822971928250469,
+		-7.069504346995201, -7.80710329012598, -7.9024134699303055, -7.80710329012598, -4.497888298175475, -7.9024134699303055, -7.314626805028186, -7.640049205462814,
+		-3.835097580096124, -7.9024134699303055, -7.9024134699303055, -4.952725134877721, -3.9627752974691854, -6.33379755201646, -5.541559468812284, -6.376357166435255,
+		-7.9024134699303055, -7.9024134699303055, -5.68320998587531, -7.9024134699303055, -6.872794052749147, -7.43240984068457, -7.720091913136351, -6.354850961214292,
+		-7.496948361822141, -6.541436916794704, -7.9024134699303055, -7.9024134699303055, -7.80710329012598, -7.80710329012598, -7.5659412333090925, -7.9024134699303055,
+		-7.9024134699303055, -7.9024134699303055, -7.9024134699303055, -7.9024134699303055, -7.9024134699303055, -7.9024134699303055, -7.371785218868134, -7.314626805028186,
+		-7.9024134699303055, -7.9024134699303055, -8.96960549663157, -8.96960549663157, -8.96960549663157, -0.13363862528587653, -8.96960549663157, -8.96960549663157,
+		-8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157,
+		-8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157,
+		-8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -2.8126265110460134, -8.96960549663157, -8.96960549663157,
+		-8.96960549663157, -8.874295316827244, -8.96960549663157, -8.96960549663157, -8.96960549663157, -7.400989578717724, -7.583311135511678, -8.96960549663157,
+		-8.96960549663157, -8.96960549663157, -8.874295316827244, -3.1266409578420307, -8.96960549663157, -8.96960549663157, -8.96960549663157, -7.93998607945041,
+		-8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -7.93998607945041, -7.608628943495968, -8.96960549663157,
+		-8.874295316827244, -8.96960549663157, -8.96960549663157, -7.443549193136519, -8.96960549663157, -8.96960549663157, -7.534520971342246, -5.719231004703997,
+		-8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -7.583311135511678,
+		-8.96960549663157, -6.019917161578984, -8.96960549663157, -7.534520971342246, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157,
+		-8.96960549663157, -7.6612726769813895, -7.301898676073493, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157,
+		-8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -8.96960549663157, -4.72248382232891,
+		-7.5013030943193275, -7.5013030943193275, -0.9701339638949783, -6.668393971384224, -5.103407821520957, -5.872062554589047, -7.5013030943193275, -6.859449208146933,
+		-2.0745925130028917, -4.476012018523792, -7.5013030943193275, -1.9095701234717495, -6.970674843257157, -6.625834356965427, -5.304078516983108, -6.402690805651218,
+		-7.5013030943193275, -3.233005224973789, -5.361236930823057, -6.402690805651218, -5.271288694160117, -4.314950461156687, -4.633404192275221, -3.674837977252828,
+		-7.5013030943193275, -4.999867142580117, -3.5519843041474846, -7.5013030943193275, -7.5013030943193275, -4.515621156618838, -7.405992914515003, -6.115008733199437,
+		-7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -7.405992914515003, -7.405992914515003, -7.5013030943193275, -4.65639371049992,
+		-6.970674843257157, -4.704021759489175, -3.472386337419682, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -6.2485401258239595, -4.883907261485248,
+		-7.5013030943193275, -5.833596273761252, -7.5013030943193275, -7.5013030943193275, -7.031299465073592, -7.405992914515003, -6.115008733199437, -6.970674843257157,
+		-7.5013030943193275, -7.318981537525373, -4.212901206802517, -7.5013030943193275, -7.5013030943193275, -5.37307138847006, -4.423990833772914, -7.405992914515003,
+		-7.164830857698115, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -6.970674843257157, -5.5843804821372665, -3.768406754788617, -7.5013030943193275,
+		-7.5013030943193275, -6.808155913759382, -6.019698553395112, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275,
+		-6.970674843257157, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275,
+		-7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -7.5013030943193275, -5.436525307056461, -5.772997543677674, -1.6703541786408782, -3.450609823387449,
+		-5.131143657505279, -5.772997543677674, -0.8818968182766083, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.185210878775555,
+		-5.772997543677674, -5.772997543677674, -5.772997543677674, -5.436525307056461, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.302993914431939,
+		-5.772997543677674, -5.772997543677674, -5.772997543677674, -4.57907507520524, -5.772997543677674, -5.772997543677674, -5.772997543677674, -4.362010569967412,
+		-5.772997543677674, -5.510633279210183, -5.59067598688372, -3.7851231955233287, -5.510633279210183, -5.772997543677674, -5.772997543677674, -5.772997543677674,
+		-5.772997543677674, -5.677687363873349, -5.772997543677674, -5.772997543677674, -4.412020990542073, -5.772997543677674, -4.94008842074257, -5.59067598688372,
+		-5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.677687363873349, -5.772997543677674,
+		-5.772997543677674, -5.772997543677674, -5.59067598688372, -5.131143657505279, -5.772997543677674, -5.772997543677674, -5.079850363117729, -5.59067598688372,
+		-5.772997543677674, -5.772997543677674, -5.36753243556951, -4.225435034961661, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674,
+		-5.772997543677674, -5.59067598688372, -5.59067598688372, -5.510633279210183, -5.772997543677674, -5.772997543677674, -5.59067598688372, -5.772997543677674,
+		-5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674,
+		-5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.772997543677674, -5.677687363873349, -5.772997543677674,
+		-5.772997543677674, -3.856510295497887, -4.955122584165997, -4.772801027372043, -2.3377267513319175, -4.859812404361672, -4.955122584165997, -4.955122584165997,
+		-4.955122584165997, -4.955122584165997, -4.955122584165997, -4.859812404361672, -4.955122584165997, -4.772801027372043, -4.955122584165997, -4.955122584165997,
+		-4.485118954920261, -4.692758319698505, -4.955122584165997, -4.955122584165997, -4.367335919263878, -4.955122584165997, -4.955122584165997, -4.955122584165997,
+		-4.549657476057833, -4.955122584165997, -4.772801027372043, -4.955122584165997, -2.940219563623732, -4.955122584165997, -4.955122584165997, -4.772801027372043,
+		-4.955122584165997, -4.618650347544784, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.772801027372043, -4.955122584165997,
+		-4.955122584165997, -4.955122584165997, -2.838867069363445, -4.955122584165997, -4.859812404361672, -4.859812404361672, -2.4955337423622863, -4.485118954920261,
+		-4.549657476057833, -4.772801027372043, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.772801027372043,
+		-4.955122584165997, -3.6741887387039327, -4.955122584165997, -4.485118954920261, -4.859812404361672, -4.955122584165997, -4.955122584165997, -4.772801027372043,
+		-4.692758319698505, -4.955122584165997, -4.955122584165997, -4.772801027372043, -4.955122584165997, -4.955122584165997, -4.772801027372043, -4.955122584165997,
+		-4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997,
+		-4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997,
+		-4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -4.955122584165997, -6.171700597410915, -0.921173894699227,
+		-1.6025030718816413, -5.4140148957133984, -6.5771657055190795, -5.353390273896964, -3.220268582753504, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795,
+		-6.5771657055190795, -5.884018524959134, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -5.142081180229757, -6.5771657055190795, -6.5771657055190795,
+		-6.5771657055190795, -4.736616072121593, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.481855525714755, -6.046537454456909,
+		-6.394844148725125, -6.481855525714755, -5.621654260491643, -2.3074682558191175, -6.5771657055190795, -2.3358389529483334, -6.481855525714755, -6.5771657055190795,
+		-6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -4.549017458226794, -6.5771657055190795,
+		-6.481855525714755, -5.2962318600570155, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795,
+		-6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.481855525714755, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795,
+		-6.5771657055190795, -6.171700597410915, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -5.24216463878674, -6.5771657055190795, -6.5771657055190795,
+		-6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795,
+		-6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795,
+		-6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -6.5771657055190795,
+		-6.5771657055190795, -6.5771657055190795, -6.5771657055190795, -5.655439578868405, -6.2972934650407995, -2.659707305314414, -3.1234150061033343, -6.2972934650407995,
+		-0.9915040836540618, -2.1605281869347466, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -4.539435547488426, -6.2972934650407995,
+		-6.2972934650407995, -6.2972934650407995, -4.910999103920909, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -5.464384342105696, -6.2972934650407995,
+		-6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -4.351383315985486, -6.2972934650407995, -6.2972934650407995, -5.766665213978629, -6.2972934650407995,
+		-2.415729667097362, -6.2972934650407995, -3.0744256189030614, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995,
+		-6.114971908246845, -6.2972934650407995, -6.2972934650407995, -4.004758707900256, -6.2972934650407995, -6.201983285236475, -3.281758564190629, -6.2972934650407995,
+		-6.2972934650407995, -6.2972934650407995, -5.103370996568365, -6.2972934650407995, -6.2972934650407995, -5.464384342105696, -6.2972934650407995, -6.2972934650407995,
+		-6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995,
+		-6.2972934650407995, -5.604146284480854, -4.440995474675174, -6.2972934650407995, -5.655439578868405, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995,
+		-6.201983285236475, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995,
+		-6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.114971908246845, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995,
+		-6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995, -6.2972934650407995,
+		-5.628699668531845, -5.628699668531845, -2.268324281389945, -5.158696039286109, -5.628699668531845, -5.366335404064354, -2.7896212050232307, -5.628699668531845,
+		-5.628699668531845, -5.628699668531845, -5.628699668531845, -5.53338948872752, -5.628699668531845, -5.628699668531845, -5.628699668531845, -3.1029710242235895,
+		-5.628699668531845, -5.628699668531845, -5.628699668531845, -4.795790545596741, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845,
+		-4.673188223504408, -5.628699668531845, -5.628699668531845, -3.296555773296255, -4.347765823069781, -1.9298698835647408, -5.628699668531845, -1.8153926360428567,
+		-5.628699668531845, -5.628699668531845, -5.53338948872752, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845,
+		-4.060083750618, -5.628699668531845, -4.293698601799505, -2.524112990065772, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845,
+		-5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.53338948872752, -5.628699668531845,
+		-5.44637811173789, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -4.599080251350687,
+		-5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.44637811173789, -5.628699668531845, -5.53338948872752,
+		-5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845,
+		-5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845,
+		-5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -5.628699668531845, -6.920276559535809, -4.3400597299434835, -2.4870816382875276,
+		-2.9056969657825706, -6.920276559535809, -6.8249663797314835, -4.477929524166605, -6.920276559535809, -2.0573681774690087, -1.8963960386895324, -6.920276559535809,
+		-4.3945479152275535, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.0873674366007045,
+		-6.920276559535809, -6.920276559535809, -6.920276559535809, -6.131819199171539, -6.920276559535809, -6.583804322914596, -6.920276559535809, -6.920276559535809,
+		-5.018169033138888, -5.23387760596558, -2.2483827415048094, -6.920276559535809, -2.2855475713061733, -6.920276559535809, -6.8249663797314835, -6.8249663797314835,
+		-6.920276559535809, -6.920276559535809, -6.583804322914596, -1.5239254527164068, -6.8249663797314835, -4.840835017855973, -6.920276559535809, -4.239255030821518,
+		-6.920276559535809, -6.920276559535809, -6.8249663797314835, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809,
+		-6.920276559535809, -6.920276559535809, -6.737955002741854, -6.8249663797314835, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809,
+		-6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809,
+		-6.920276559535809, -6.920276559535809, -5.509289585825547, -6.920276559535809, -6.5148114514276445, -6.389648308473638, -6.920276559535809, -6.737955002741854,
+		-6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.8249663797314835,
+		-6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.920276559535809, -6.003985827661654, -6.920276559535809,
+		-6.920276559535809, -6.920276559535809, -4.206076489835546, -4.117348374226861, -1.7822778260883272, -2.4636227815916447, -6.008198746099147, -6.478202375344883,
+		-1.5125644471793627, -6.478202375344883, -6.478202375344883, -6.478202375344883, -4.532292226289569, -1.9509937308265033, -6.478202375344883, -6.478202375344883,
+		-6.478202375344883, -3.3962924055498394, -6.295880818550928, -6.478202375344883, -6.478202375344883, -3.9287572044193113, -6.478202375344883, -6.478202375344883,
+		-6.478202375344883, -4.737736200504378, -6.478202375344883, -6.14173013872367, -6.478202375344883, -5.019587352645366, -4.93063986662887, -3.5878306174487182,
+		-5.315051565539202, -3.4725197709377236, -5.645293252409779, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883,
+		-6.478202375344883, -6.478202375344883, -4.737736200504378, -6.382892195540558, -5.9475741242827125, -3.6332929915254755, -4.546680963741669, -6.295880818550928,
+		-6.2158381108773915, -6.2158381108773915, -5.890415710442764, -6.478202375344883, -5.067215401634621, -6.478202375344883, -6.478202375344883, -6.478202375344883,
+		-6.295880818550928, -5.448582958163724, -6.478202375344883, -6.478202375344883, -6.072737267236718, -5.197268529882819, -6.478202375344883, -6.478202375344883,
+		-5.284279906872448, -4.146058480109293, -6.478202375344883, -5.736265030615505, -5.736265030615505, -6.478202375344883, -6.478202375344883, -6.478202375344883,
+		-6.478202375344883, -6.2158381108773915, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883,
+		-6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883,
+		-6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -6.478202375344883, -5.6798312538336875,
+		-5.6798312538336875, -5.6798312538336875, -3.3974488681571615, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875,
+		-5.3433590172124745, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875,
+		-5.6798312538336875, -4.3988974083716235, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875,
+		-5.6798312538336875, -5.6798312538336875, -2.204764023605077, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875,
+		-5.6798312538336875, -5.6798312538336875, -2.4064672436814174, -3.4715568403108836, -3.888071784605633, -5.6798312538336875, -5.6798312538336875, -3.1621347812226968,
+		-5.6798312538336875, -2.888666146020971, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875,
+		-5.6798312538336875, -5.6798312538336875, -3.3574435335434623, -3.719736469786418, -5.6798312538336875, -5.584521074029363, -5.6798312538336875, -5.6798312538336875,
+		-3.377246160839642, -5.274366145725524, -5.6798312538336875, -3.0771415683893037, -2.602518993287274, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875,
+		-5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -3.5756970995634805, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875,
+		-3.539765090337417, -4.763540521959532, -5.584521074029363, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -3.4826066764974684, -3.377246160839642,
+		-5.6798312538336875, -5.4975096970397335, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875,
+		-5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.6798312538336875, -5.641907070938114, -5.641907070938114, -5.641907070938114, -3.3697811854287765,
+		-5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.171903441692378, -5.641907070938114, -5.641907070938114,
+		-5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -4.80899794800301, -5.641907070938114,
+		-5.641907070938114, -5.641907070938114, -5.546596891133789, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -2.288500353112307,
+		-5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -3.4118926707789035,
+		-3.2082937155376636, -3.91914047319701, -5.641907070938114, -5.641907070938114, -3.0318372781961074, -5.641907070938114, -2.70805020110221, -5.641907070938114,
+		-5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -3.076957713476577,
+		-2.78543686471763, -5.546596891133789, -5.641907070938114, -5.641907070938114, -5.641907070938114, -2.954059577153423, -5.171903441692378, -5.641907070938114,
+		-3.433632657415309, -3.26236093680794, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114,
+		-5.641907070938114, -3.681812286890844, -5.641907070938114, -5.641907070938114, -5.641907070938114, -2.947279890168044, -4.648655297927831, -5.641907070938114,
+		-5.641907070938114, -5.641907070938114, -5.641907070938114, -3.6540327227837683, -3.422703586883119, -5.641907070938114, -5.546596891133789, -5.641907070938114,
+		-5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.641907070938114, -5.546596891133789, -5.641907070938114, -5.641907070938114,
+		-5.641907070938114, -6.268148865350222, -6.268148865350222, -6.268148865350222, -1.1102436525189299, -6.268148865350222, -6.268148865350222, -6.268148865350222,
+		-6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222,
+		-6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222,
+		-6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -3.726546871885676, -6.268148865350222, -6.268148865350222, -6.268148865350222,
+		-6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -5.680362200448102, -6.268148865350222,
+		-6.268148865350222, -3.355798200735282, -0.8668237487279559, -6.268148865350222, -6.268148865350222, -6.268148865350222, -5.575001684790276, -6.268148865350222,
+		-6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222,
+		-6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222,
+		-6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -5.680362200448102, -6.268148865350222,
+		-3.9360049701146314, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222,
+		-6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222,
+		-6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -6.268148865350222, -5.629418059367339, -5.629418059367339,
+		-5.629418059367339, -0.6417102699147876, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339,
+		-5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339,
+		-5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339,
+		-5.534107879563014, -2.045899120911229, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339,
+		-5.629418059367339, -5.223952951259174, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339,
+		-5.629418059367339, -5.629418059367339, -5.629418059367339, -5.534107879563014, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339,
+		-5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339,
+		-5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339,
+		-5.629418059367339, -5.629418059367339, -5.629418059367339, -5.159414430121603, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339,
+		-5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.367053794899848, -5.629418059367339,
+		-4.243123698247448, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.629418059367339, -5.534107879563014,
+		-5.447096502573384, -5.629418059367339, -5.629418059367339, -6.574238670186556, -6.574238670186556, -2.9132444195621563, -5.092634129262342, -5.213262117050956,
+		-2.8294515841343246, -1.6682234252204036, -6.574238670186556, -4.1406253147861065, -6.4789284903822315, -6.574238670186556, -3.553813784042194, -6.574238670186556,
+		-6.574238670186556, -6.574238670186556, -4.149435944468261, -5.265905850536378, -6.574238670186556, -6.574238670186556, -4.281703913046012, -6.574238670186556,
+		-6.574238670186556, -6.574238670186556, -5.785781309822286, -6.4789284903822315, -6.4789284903822315, -6.4789284903822315, -5.698769932832657, -3.217341547420981,
+		-2.240877207493956, -6.4789284903822315, -1.1431401924047015, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556,
+		-6.574238670186556, -6.574238670186556, -6.574238670186556, -5.048182366691507, -6.574238670186556, -6.391917113392602, -4.717940679820931, -6.1042350409408215,
+		-6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.237766433565343, -6.574238670186556, -6.574238670186556,
+		-6.574238670186556, -6.391917113392602, -5.544619253005399, -6.574238670186556, -6.574238670186556, -6.4789284903822315, -6.237766433565343, -6.574238670186556,
+		-6.574238670186556, -6.391917113392602, -4.833772495346052, -6.574238670186556, -6.043610419124386, -6.574238670186556, -6.574238670186556, -6.574238670186556,
+		-6.4789284903822315, -6.574238670186556, -6.391917113392602, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556,
+		-6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556,
+		-6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556, -6.574238670186556,
+		-4.7340030578310595, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.653960350157523, -4.916324614625014, -4.446320985379279, -4.916324614625014,
+		-4.916324614625014, -4.653960350157523, -4.916324614625014, -4.916324614625014, -4.82101443482069, -4.916324614625014, -4.82101443482069, -4.446320985379279,
+		-4.7340030578310595, -4.916324614625014, -4.82101443482069, -4.82101443482069, -4.7340030578310595, -4.916324614625014, -4.82101443482069, -4.916324614625014,
+		-4.82101443482069, -4.916324614625014, -4.82101443482069, -4.916324614625014, -4.916324614625014, -4.7340030578310595, -4.916324614625014, -4.7340030578310595,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -3.5053376409147523, -4.579852378003801, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -1.559427491859439, -4.916324614625014, -4.446320985379279, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.7340030578310595, -4.579852378003801, -4.916324614625014, -4.916324614625014, -4.7340030578310595,
+		-4.916324614625014, -4.579852378003801, -4.916324614625014, -4.82101443482069, -3.1413722637133406, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.82101443482069, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.7340030578310595, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.446320985379279,
+		-4.174387269895637, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -5.6777806721172, -5.6777806721172, -5.6777806721172,
+		-3.316926670999179, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.14715242105503, -5.6777806721172,
+		-5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -4.266793698406938,
+		-5.582470492312876, -5.415416407649709, -5.6777806721172, -5.582470492312876, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172,
+		-2.345576161941996, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172,
+		-3.090016636889492, -3.1128313146556637, -3.853231380066154, -5.6777806721172, -5.6777806721172, -2.898961400126783, -5.207777042871465, -2.770879612269825,
+		-5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172,
+		-3.209681140645581, -3.5030289506330394, -5.6777806721172, -5.582470492312876, -5.6777806721172, -5.4954591153232455, -3.2618668938161512, -5.089994007215081,
+		-5.6777806721172, -3.2441673167167506, -2.6622457712670298, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172,
+		-5.6777806721172, -5.6777806721172, -3.731870523061887, -5.6777806721172, -5.6777806721172, -5.6777806721172, -3.480556094780981, -4.844871549182097,
+		-5.14715242105503, -5.6777806721172, -5.6777806721172, -5.6777806721172, -3.34563677688161, -3.2267755740048814, -5.6777806721172, -5.4954591153232455,
+		-5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.6777806721172, -5.582470492312876, -5.6777806721172,
+		-5.6777806721172, -5.6777806721172, -4.916324614625014, -4.916324614625014, -4.916324614625014, -2.5275618253899164, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.7340030578310595, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -1.4258960992349163, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.653960350157523, -4.916324614625014, -4.653960350157523, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.82101443482069, -4.82101443482069, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.653960350157523,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.653960350157523, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014,
+		-4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.916324614625014, -4.7340030578310595, -3.8542614685695984,
+		-6.62685019080938, -2.0888887545147385, -3.3497054578172034, -6.62685019080938, -6.62685019080938, -1.6296379170452648, -6.62685019080938, -6.62685019080938,
+		-6.62685019080938, -4.625370190599256, -5.291849124077039, -6.62685019080938, -6.62685019080938, -6.62685019080938, -4.256606449341519, -5.145245649885164,
+		-6.62685019080938, -6.62685019080938, -4.510594676006828, -6.62685019080938, -6.62685019080938, -6.62685019080938, -4.324265097815334, -6.62685019080938,
+		-6.364485926341889, -6.62685019080938, -5.0174122783752795, -6.62685019080938, -2.335021824053647, -4.8185614196301145, -1.284037584374792, -3.3960457950759055,
+		-6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938, -6.531540011005055, -6.62685019080938, -6.62685019080938, -4.9591433702513035,
+		-6.531540011005055, -5.710559458935225, -4.475087987549918, -5.52823790214127, -6.62685019080938, -6.62685019080938, -5.597230773628222, -6.444528634015425,
+		-6.62685019080938, -6.290377954188167, -6.62685019080938, -6.62685019080938, -6.531540011005055, -6.156846561563644, -6.221385082701215, -6.62685019080938,
+		-6.62685019080938, -5.793941067874276, -6.039063525907261, -6.62685019080938, -6.62685019080938, -6.531540011005055, -4.940451237239151, -5.597230773628222,
+		-5.933703010249435, -6.444528634015425, -6.62685019080938, -6.62685019080938, -6.290377954188167, -6.62685019080938, -6.290377954188167, -6.62685019080938,
+		-6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938,
+		-6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938,
+		-6.62685019080938, -6.62685019080938, -6.62685019080938, -6.62685019080938, -5.987205490187598, -5.8048839333936435, -5.987205490187598, -3.1193065881434925,
+		-5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.581740382079434, -5.987205490187598, -5.987205490187598,
+		-5.581740382079434, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -4.855803378696498, -5.987205490187598,
+		-5.724841225720107, -5.987205490187598, -5.724841225720107, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -2.346991208054943,
+		-5.987205490187598, -5.987205490187598, -5.987205490187598, -5.891895310383274, -5.987205490187598, -5.987205490187598, -5.987205490187598, -2.9145121754974794,
+		-3.032295211153863, -3.6076593560574244, -5.987205490187598, -5.987205490187598, -2.873690180977224, -5.987205490187598, -2.8916278816638914, -5.987205490187598,
+		-5.987205490187598, -5.8048839333936435, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -3.20219424794926,
+		-3.1899241553574456, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -3.136498988683865, -4.418589572273754, -5.987205490187598,
+		-3.142296106368191, -2.5372179443560112, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598,
+		-5.987205490187598, -3.8471393266913276, -5.987205490187598, -5.987205490187598, -5.987205490187598, -3.053348620351695, -5.154296367252495, -5.8048839333936435,
+		-5.987205490187598, -5.987205490187598, -5.987205490187598, -3.239934575932107, -3.1080070328895593, -5.987205490187598, -5.724841225720107, -5.987205490187598,
+		-5.8048839333936435, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.987205490187598, -5.891895310383274, -5.987205490187598, -5.987205490187598,
+		-5.987205490187598, -5.989963420981715, -5.989963420981715, -5.989963420981715, -2.1440802180581136, -5.989963420981715, -5.989963420981715, -5.989963420981715,
+		-5.989963420981715, -5.989963420981715, -5.7275991565142235, -5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715,
+		-5.989963420981715, -5.989963420981715, -5.989963420981715, -4.681630601331536, -5.989963420981715, -5.989963420981715, -5.989963420981715, -5.80764186418776,
+		-5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715, -2.5788157084663914, -5.989963420981715, -5.989963420981715, -5.989963420981715,
+		-5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715, -3.5050567711937144, -3.6481576148343877, -3.923100661508739, -5.989963420981715,
+		-5.989963420981715, -2.9598297207103914, -5.989963420981715, -2.5689634120233795, -5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715,
+		-5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715, -3.73867162237522, -3.728200322507924, -5.989963420981715, -5.80764186418776,
+		-5.989963420981715, -5.80764186418776, -3.707581035305189, -5.07367268910756, -5.989963420981715, -3.464234776673459, -1.637108163608113, -5.989963420981715,
+		-5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715, -3.935839687286169, -5.989963420981715,
+		-5.653491184360502, -5.989963420981715, -3.717837535472378, -4.508358880057499, -5.58449831287355, -5.989963420981715, -5.989963420981715, -5.989963420981715,
+		-3.838201217722253, -3.861731715132447, -5.989963420981715, -5.89465324117739, -5.80764186418776, -5.653491184360502, -5.989963420981715, -5.989963420981715,
+		-5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715, -5.989963420981715, -5.117993812416755, -5.906451172781026,
+		-2.110961983608831, -3.205089959829612, -5.8111409929767, -5.906451172781026, -1.8005074747155727, -5.906451172781026, -5.906451172781026, -5.906451172781026,
+		-5.8111409929767, -4.358888664065012, -5.906451172781026, -5.906451172781026, -5.906451172781026, -3.536207431313165, -5.724129615987071, -5.906451172781026,
+		-5.906451172781026, -3.7431281471204874, -5.906451172781026, -5.724129615987071, -5.906451172781026, -4.7433003629753445, -5.26459728660863, -5.724129615987071,
+		-5.906451172781026, -5.500986064672861, -3.9463563887337556, -2.1177263836973728, -5.569978936159813, -2.1545969195057006, -4.775049061289924, -5.906451172781026,
+		-5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -4.841740435788597, -5.906451172781026,
+		-5.26459728660863, -3.097048477418528, -5.030982435427125, -5.906451172781026, -5.569978936159813, -5.724129615987071, -5.569978936159813, -5.906451172781026,
+		-5.724129615987071, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.43644754353529, -5.906451172781026, -5.906451172781026,
+		-5.8111409929767, -5.724129615987071, -5.906451172781026, -5.906451172781026, -4.183684575039922, -3.9049711725709013, -5.906451172781026, -4.520156811661135,
+		-5.8111409929767, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.117993812416755, -5.906451172781026, -5.906451172781026,
+		-5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026,
+		-5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026, -5.906451172781026,
+		-5.906451172781026, -5.906451172781026, -5.906451172781026, -4.733074666080812, -2.3311471297545032, -5.900679826235873, -4.120896548054534, -4.826165089146825,
+		-2.9752167485284384, -5.448694702492816, -6.141841883052761, -4.073829037196548, -3.7275528004787146, -5.207532645675928, -3.5909772654729637, -1.5578024786947433,
+		-5.105749951365985, -5.013376631234971, -6.054830506063132, -5.7065238117949155, -5.28164061782965, -3.542524882087017, -5.767148433611351, -5.404242939921982,
+		-5.243900289846803, -3.711423418548831, -4.802067537567764, -3.7275528004787146, -5.7065238117949155, -5.974787798389595, -5.7065238117949155, -6.237152062857086,
+		-5.7065238117949155, -4.755547521932871, -5.7065238117949155, -5.074001253051406, -6.237152062857086, -6.237152062857086, -6.237152062857086, -6.237152062857086,
+		-6.237152062857086, -3.9753889643832956, -5.105749951365985, -5.7065238117949155, -6.237152062857086, -5.7065238117949155, -5.544004882297141, -5.7065238117949155,
+		-6.237152062857086, -4.984389094361719, -3.155242093062043, -5.172441325864658, -5.544004882297141, -3.4277493674945885, -6.237152062857086, -6.237152062857086,
+		-6.141841883052761, -6.141841883052761, -4.38085407249146, -6.237152062857086, -6.237152062857086, -6.141841883052761, -2.673269098917835, -6.237152062857086,
+		-6.237152062857086, -3.8483892736219882, -4.305630651253873, -4.876175509721485, -3.8762980617390648, -4.711095759362037, -5.900679826235873, -6.237152062857086,
+		-5.7065238117949155, -6.141841883052761, -5.974787798389595, -6.237152062857086, -6.237152062857086, -6.237152062857086, -6.054830506063132, -6.237152062857086,
+		-6.237152062857086, -6.237152062857086, -6.237152062857086, -6.237152062857086, -6.237152062857086, -6.237152062857086, -6.237152062857086, -6.237152062857086,
+		-6.237152062857086, -6.237152062857086, -6.237152062857086, -6.237152062857086, -6.141841883052761, -6.237152062857086, -6.237152062857086, -6.237152062857086,
+		-5.4169888962655355, -5.4169888962655355, -2.2021210927948736, -5.4169888962655355, -5.4169888962655355, -5.321678716461211, -2.0741270916163437, -5.4169888962655355,
+		-5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -1.923516238494209, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -3.93538435534132,
+		-5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -4.77513501009314, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355,
+		-5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.234667339471581, -5.4169888962655355, -1.8965280937765623,
+		-5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355,
+		-3.186974496106325, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355,
+		-5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355,
+		-5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -4.628531535901265,
+		-5.4169888962655355, -4.285586784774435, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.321678716461211,
+		-5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355,
+		-5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355,
+		-5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -5.4169888962655355, -3.15171613446291, -4.129491949987746, -5.540478923698008,
+		-4.232146104047829, -5.009850672635838, -4.752021563333738, -5.540478923698008, -5.540478923698008, -5.540478923698008, -4.129491949987746, -5.1350138155898435,
+		-5.540478923698008, -1.0711284608524518, -5.540478923698008, -5.278114659230518, -4.28771595520264, -4.154184562578117, -5.070475294452272, -5.445168743893683,
+		-4.707569800762904, -5.540478923698008, -4.752021563333738, -4.547227150687725, -3.638371397301088, -3.4863551900024623, -5.540478923698008, -5.540478923698008,
+		-5.540478923698008, -5.540478923698008, -5.540478923698008, -4.707569800762904, -5.540478923698008, -5.070475294452272, -5.540478923698008, -5.540478923698008,
+		-5.540478923698008, -5.540478923698008, -5.540478923698008, -4.259545078235944, -5.540478923698008, -4.665010186344109, -5.540478923698008, -5.540478923698008,
+		-5.070475294452272, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.358157366904054, -5.540478923698008, -5.540478923698008, -3.0308796613196365,
+		-5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -4.036401526921734, -5.540478923698008, -5.540478923698008, -5.540478923698008,
+		-3.594568774642695, -5.540478923698008, -5.540478923698008, -4.036401526921734, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008,
+		-5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008,
+		-5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.445168743893683, -5.540478923698008,
+		-5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008, -5.540478923698008,
+		-5.540478923698008, -5.540478923698008, -5.548297571815806, -4.906443685643412, -3.1780538303479458, -3.959062366699225, -5.548297571815806, -3.4441634175455986,
+		-3.123494846097511, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -1.3436049524248401, -3.520149324523521, -5.548297571815806,
+		-5.548297571815806, -5.211825335194593, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.452987392011481, -5.548297571815806, -5.548297571815806,
+		-5.548297571815806, -4.759840211451536, -3.9388596593817056, -5.548297571815806, -5.548297571815806, -5.1428324637076415, -5.452987392011481, -2.3294217469476055,
+		-5.548297571815806, -2.3374539186448695, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806,
+		-5.548297571815806, -5.452987392011481, -4.239964752165627, -5.548297571815806, -4.9605109069136875, -4.906443685643412, -5.548297571815806, -5.548297571815806,
+		-5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806,
+		-5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.452987392011481, -5.548297571815806, -5.548297571815806, -5.548297571815806,
+		-5.365976015021851, -5.452987392011481, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.452987392011481,
+		-5.548297571815806, -5.452987392011481, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806,
+		-5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806,
+		-5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -5.548297571815806, -6.127087500892697,
+		-3.684740465523492, -1.061964021512371, -3.7852816947453696, -6.127087500892697, -6.127087500892697, -1.864407623851381, -6.127087500892697, -6.127087500892697,
+		-6.127087500892697, -6.127087500892697, -3.2938741568364804, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697,
+		-5.790615264271484, -6.127087500892697, -6.127087500892697, -5.028475212224587, -6.031777321088372, -5.944765944098742, -6.127087500892697, -4.010831986090144,
+		-6.127087500892697, -4.139213152738351, -5.485233614720301, -4.692002975603374, -2.4712479008569606, -6.127087500892697, -2.770190378127121, -6.127087500892697,
+		-6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -5.944765944098742, -6.127087500892697, -6.127087500892697, -5.097468083711538,
+		-6.127087500892697, -4.517649588458596, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697,
+		-6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -5.721622392784532, -6.031777321088372, -6.127087500892697, -6.127087500892697,
+		-6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697,
+		-6.127087500892697, -6.127087500892697, -3.8970731007334862, -6.127087500892697, -5.539300835990577, -6.127087500892697, -6.127087500892697, -6.127087500892697,
+		-6.127087500892697, -6.031777321088372, -5.944765944098742, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697,
+		-6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697,
+		-6.127087500892697, -6.127087500892697, -6.127087500892697, -6.127087500892697, -3.8522730010223722, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.363098624788362,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317, -4.545420181582317,
+		-4.545420181582317, -3.4697868503221896, -3.9124107418829994, -4.295957164283518, -6.505451834211551, -5.186168183374621, -4.114313343876061, -4.244460638516343,
+		-5.457483278362196, -3.6787958907014944, -2.8343215616018975, -5.951141098505822, -5.312652330483741, -4.25221261532066, -3.7998738222547304, -4.513021669521345,
+		-5.658153973824348, -5.658153973824348, -5.370471901372567, -3.6316779677244244, -4.263954433197344, -4.029847577085865, -5.793955514983409, -3.1706053145735087,
+		-4.9492584362962635, -4.094178435467005, -5.470555359929549, -4.202866741217505, -4.707538499409118, -5.346941403962373, -3.97528859283543, -4.866814767085189,
+		-3.145848349848233, -5.406839545543442, -6.80555642666189, -7.236339342754344, -4.543793328188099, -5.206168850081291, -7.4987036072218345, -4.138328220079934,
+		-3.794935540614148, -7.40339342741751, -5.047698509109516, -6.910916942319716, -4.087555894706512, -6.710246246857564, -7.4987036072218345, -4.981007134610843,
+		-5.431840847748859, -6.367301495730734, -7.4987036072218345, -5.596596080824915, -6.582412875347679, -6.335552797416154, -7.3163820504278805, -7.4987036072218345,
+		-5.206168850081291, -7.4987036072218345, -5.793955514983409, -7.4987036072218345, -5.690414836042569, -4.933754249760298, -7.40339342741751, -5.70694413799378,
+		-5.70694413799378, -6.367301495730734, -6.505451834211551, -6.710246246857564, -7.1622313706006215, -7.4987036072218345, -1.101440674215568, -7.40339342741751,
+		-6.710246246857564, -6.756766262492458, -6.163702540489495, -7.4987036072218345, -7.40339342741751, -7.40339342741751, -7.4987036072218345, -7.3163820504278805,
+		-5.24741180861534, -5.27950012316684, -6.80555642666189, -7.4987036072218345, -5.48380058667957, -7.4987036072218345, -7.4987036072218345, -7.4987036072218345,
+		-7.4987036072218345, -7.4987036072218345, -4.713692364983497, -7.4987036072218345, -7.4987036072218345, -7.40339342741751, -4.857225080796721, -5.039546637590676,
+		-5.039546637590676, -4.634081529482511, -4.508918386528506, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676,
+		-5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -4.944236457786351, -5.039546637590676, -5.039546637590676,
+		-5.039546637590676, -4.634081529482511, -5.039546637590676, -5.039546637590676, -5.039546637590676, -4.944236457786351, -5.039546637590676, -5.039546637590676,
+		-5.039546637590676, -2.8534953608525817, -5.039546637590676, -5.039546637590676, -4.777182373123185, -4.857225080796721, -5.039546637590676, -5.039546637590676,
+		-5.039546637590676, -5.039546637590676, -5.039546637590676, -4.944236457786351, -5.039546637590676, -5.039546637590676, -4.297609292861298, -5.039546637590676,
+		-4.3463994570307305, -2.11638505687152, -5.039546637590676, -5.039546637590676, -5.039546637590676, -4.944236457786351, -2.49794464412613, -5.039546637590676,
+		-5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -4.944236457786351, -5.039546637590676, -4.944236457786351, -5.039546637590676,
+		-5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676,
+		-5.039546637590676, -5.039546637590676, -5.039546637590676, -2.7777835391168852, -2.614743911872381, -4.857225080796721, -5.039546637590676, -5.039546637590676,
+		-5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676,
+		-5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676, -5.039546637590676,
+		-5.039546637590676, -5.039546637590676, -5.039546637590676, -4.983423956624259, -6.122858239812624, -5.349668351579142, -2.504088351885714, -5.866924865675424,
+		-4.3036997963964545, -6.005075204156241, -6.903016797362199, -4.513420327378523, -2.107226251765458, -6.0428155321390875, -6.5283233479207885, -6.59286186905836,
+		-6.16541785423142, -6.5283233479207885, -5.563242451877201, -6.122858239812624, -6.998326977166524, -6.661854740545311, -5.330620156608448, -4.757617287890565,
+		-4.232007867940339, -0.67576173723924, -5.689994157516345, -3.2141373432482627, -5.717393131704459, -4.464630163209092, -3.763577803142033, -5.717393131704459,
+		-6.256389632437147, -5.9336162401740955, -5.25786080232602, -6.005075204156241, -6.998326977166524, -6.998326977166524, -6.998326977166524, -6.998326977166524,
+		-6.816005420372569, -6.467698726104354, -6.005075204156241, -5.866924865675424, -5.349668351579142, -5.968707559985366, -4.6004317043681535, -5.311928023596296,
+		-6.816005420372569, -4.970178729874239, -5.899714688498414, -6.5283233479207885, -6.998326977166524, -6.816005420372569, -6.903016797362199, -6.816005420372569,
+		-6.59286186905836, -6.903016797362199, -5.866924865675424, -6.998326977166524, -6.903016797362199, -6.998326977166524, -5.7745515455444085, -6.998326977166524,
+		-6.998326977166524, -6.305179796606579, -5.745564008671156, -6.467698726104354, -6.410540312264405, -6.5283233479207885, -6.998326977166524, -6.998326977166524,
+		-6.305179796606579, -6.998326977166524, -6.903016797362199, -6.998326977166524, -6.256389632437147, -6.735962712699033, -5.835176167360843, -6.735962712699033,
+		-6.998326977166524, -6.903016797362199, -6.998326977166524, -6.998326977166524, -6.998326977166524, -6.998326977166524, -6.998326977166524, -6.998326977166524,
+		-6.998326977166524, -6.998326977166524, -6.998326977166524, -6.998326977166524, -6.998326977166524, -6.998326977166524, -6.998326977166524, -6.998326977166524,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -5.946168272192805, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -0.18997408119706938, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.100318952020064, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019,
+		-6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -6.282640508814019, -5.845571346927579, -5.845571346927579, -5.845571346927579,
+		-3.573445461418242, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.663249790133624, -5.845571346927579,
+		-5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -4.714169235436478,
+		-5.845571346927579, -5.750261167123254, -5.845571346927579, -5.750261167123254, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579,
+		-2.414815163023879, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579,
+		-2.7499937384038717, -2.9891011407070955, -4.122804749186475, -5.845571346927579, -5.845571346927579, -3.1853118096617172, -5.845571346927579, -3.0919106345733165,
+		-5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579,
+		-2.8010489092041557, -2.955199589031414, -5.845571346927579, -5.750261167123254, -5.845571346927579, -5.845571346927579, -2.630703543456917, -5.203717460755184,
+		-5.845571346927579, -3.17142269750105, -2.782180424899773, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579,
+		-5.845571346927579, -5.845571346927579, -3.914049935324365, -5.845571346927579, -5.845571346927579, -5.845571346927579, -3.2355015541855727, -4.929280615053424,
+		-5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -3.2136825067909327, -3.2281755140934996, -5.845571346927579, -5.845571346927579,
+		-5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.845571346927579, -5.750261167123254, -5.845571346927579,
+		-5.845571346927579, -5.845571346927579, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -0.30915238093134895, -6.0960497622980565, -6.0960497622980565,
+		-6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565,
+		-6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565,
+		-6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -2.861300588273566, -6.0960497622980565, -6.0960497622980565,
+		-6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565,
+		-6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -5.759577525676844, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.000739582493732,
+		-6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565,
+		-6.0960497622980565, -6.0960497622980565, -6.000739582493732, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565,
+		-6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -5.354112417568679,
+		-6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565,
+		-6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565,
+		-6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.0960497622980565, -6.2314652154886145,
+		-6.2314652154886145, -6.2314652154886145, -1.274230698119474, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145,
+		-6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145,
+		-6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145,
+		-6.2314652154886145, -6.2314652154886145, -2.836956821977256, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145,
+		-6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.04914365869466, -6.2314652154886145, -6.2314652154886145, -3.666515858027078,
+		-0.8256396412252927, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145,
+		-6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145,
+		-6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145,
+		-6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -5.538318034928669, -6.2314652154886145, -3.7978518600881648, -6.2314652154886145,
+		-6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145,
+		-6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145, -6.2314652154886145,
+		-6.2314652154886145, -6.04914365869466, -6.2314652154886145, -6.2314652154886145, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -1.9865776914940196,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.418840607796598, -4.681204872264089, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.09341820736197, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089, -4.681204872264089,
+		-4.681204872264089, -4.541164856012179, -4.541164856012179, -4.541164856012179, -3.95337819111006, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.6443908991413725, -4.6443908991413725,
+		-4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725,
+		-4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725,
+		-4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725,
+		-4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725,
+		-4.6443908991413725, -2.9766840785832964, -4.056604234239254, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725,
+		-4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725,
+		-4.6443908991413725, -4.462069342347418, -4.3079186625201595, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.174387269895637,
+		-4.6443908991413725, -4.6443908991413725, -3.512988787650272, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725,
+		-4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.382026634673881,
+		-4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -3.7281001672672174, -4.056604234239254, -4.6443908991413725,
+		-4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -4.6443908991413725,
+		-4.6443908991413725, -4.6443908991413725, -4.6443908991413725, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -3.233017778624956, -5.8132346082172806,
+		-5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.225447943315162, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806,
+		-5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -4.589459176595165, -5.8132346082172806, -5.717924428412956,
+		-5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -2.5285710428110773, -5.8132346082172806,
+		-5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -2.9741561447086666, -3.2254705729895727,
+		-3.89631199603522, -5.8132346082172806, -5.8132346082172806, -2.895463876133002, -5.171380722044886, -3.0038319128547832, -5.8132346082172806, -5.8132346082172806,
+		-5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.717924428412956, -5.8132346082172806, -2.9918557218080677, -3.09193918036505,
+		-5.8132346082172806, -5.717924428412956, -5.8132346082172806, -5.8132346082172806, -3.0659636939617894, -5.120087427657335, -5.8132346082172806, -2.945335706173175,
+		-2.9118130141345313, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806,
+		-3.8253602600629355, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -3.009874227310746, -5.071297263487904, -5.8132346082172806, -5.8132346082172806,
+		-5.8132346082172806, -5.8132346082172806, -3.1460064016353257, -2.9683252243978737, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.6309130514233265,
+		-5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806, -5.8132346082172806,
+		-5.835395361458093, -5.740085181653768, -5.835395361458093, -3.4375000886597227, -5.835395361458093, -5.1935414752856985, -5.835395361458093, -5.835395361458093,
+		-5.835395361458093, -5.5730310969906025, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093,
+		-5.835395361458093, -5.835395361458093, -4.672244551652413, -5.835395361458093, -5.5730310969906025, -5.835395361458093, -5.835395361458093, -5.835395361458093,
+		-5.835395361458093, -5.835395361458093, -5.835395361458093, -2.4544006871134574, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093,
+		-5.835395361458093, -5.835395361458093, -5.835395361458093, -3.0442302536453765, -3.1543738327438025, -3.5328102684640474, -5.835395361458093, -5.835395361458093,
+		-2.9561969041600538, -5.835395361458093, -2.996316897949479, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093,
+		-5.835395361458093, -5.835395361458093, -5.835395361458093, -3.1010278520385097, -3.1475478676734028, -5.835395361458093, -5.835395361458093, -5.835395361458093,
+		-5.835395361458093, -3.0080817395290653, -4.770684624465665, -5.835395361458093, -2.814970475313731, -2.7042584508978993, -5.835395361458093, -5.835395361458093,
+		-5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093, -3.731261207187886, -5.835395361458093, -5.835395361458093,
+		-5.835395361458093, -3.1140999336058623, -5.046938001093823, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093, -3.075385421425172,
+		-3.3176988888471017, -5.835395361458093, -5.740085181653768, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093,
+		-5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093, -5.835395361458093, -4.677490847567718, -2.9025384966560437, -3.984343667007772,
+		-4.495169290773763, -4.677490847567718, -4.677490847567718, -4.582180667763392, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718,
+		-4.035636961395323, -3.684239074557434, -4.677490847567718, -4.677490847567718, -4.341018610946504, -4.677490847567718, -4.677490847567718, -4.677490847567718,
+		-4.415126583100227, -4.146862596505547, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718,
+		-4.677490847567718, -4.677490847567718, -4.495169290773763, -4.677490847567718, -3.242406322278395, -4.677490847567718, -4.677490847567718, -4.677490847567718,
+		-4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.582180667763392, -4.272025739459553, -4.677490847567718, -4.677490847567718,
+		-4.582180667763392, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718,
+		-4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718,
+		-4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.495169290773763, -4.677490847567718, -4.677490847567718, -4.677490847567718,
+		-4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718,
+		-4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718,
+		-4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718, -4.677490847567718,
+		-4.677490847567718, -4.677490847567718, -4.5700139290035136, -4.483002552013883, -4.134695857745668, -4.402959844340347, -4.5700139290035136, -4.665324108807838,
+		-4.665324108807838, -4.665324108807838, -4.483002552013883, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.483002552013883,
+		-4.07753744390572, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.328851872186625, -4.665324108807838, -4.195320479562103, -4.665324108807838,
+		-4.665324108807838, -4.665324108807838, -4.483002552013883, -4.665324108807838, -4.665324108807838, -4.483002552013883, -4.5700139290035136, -4.665324108807838,
+		-4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.023470222635444, -4.665324108807838, -4.665324108807838,
+		-3.7898553714539385, -3.749033376933683, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838,
+		-4.5700139290035136, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.483002552013883, -4.5700139290035136, -4.665324108807838,
+		-4.665324108807838, -4.665324108807838, -4.665324108807838, -4.483002552013883, -4.665324108807838, -4.483002552013883, -4.07753744390572, -4.483002552013883,
+		-4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838,
+		-4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838,
+		-4.665324108807838, -4.328851872186625, -4.665324108807838, -4.665324108807838, -3.2543371350975763, -4.665324108807838, -4.665324108807838, -4.665324108807838,
+		-4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.665324108807838, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.220487617062456, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -3.6327009521603366, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.530642545366295, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -2.571828991475074, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619,
+		-4.625952725170619, -4.625952725170619, -4.625952725170619, -4.625952725170619, -4.541164856012179, -4.541164856012179, -4.541164856012179, -3.95337819111006,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.534747721691545, -4.352426164897591, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -3.95337819111006, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179, -4.541164856012179,
+		-4.541164856012179, -4.541164856012179, -4.541164856012179, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.440509928048973, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.353498551059343, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298,
+		-4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298, -4.535820107853298,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.352426164897591, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -2.9467788913174404, -4.875041523559176, -4.192150843166419,
+		-1.732473891754299, -4.41015299498021, -5.029192203386434, -5.270354260203322, -5.994273099430021, -3.8468808958416774, -3.922674735211211, -5.029192203386434,
+		-5.822422842503362, -5.365664440007647, -4.436128481383471, -4.895660810761911, -4.5183665796204435, -5.00566170597624, -6.127804492054544, -4.689324377764082,
+		-3.979370078887756, -4.423056399816119, -5.155943909025577, -3.8323878885391105, -3.4635569136107263, -4.423056399816119, -4.223567039399798, -4.835036188945477,
+		-4.094032987207896, -6.464276728675756, -4.672517259447702, -3.704266788642835, -4.057331620357468, -4.476402380521411, -6.464276728675756, -6.464276728675756,
+		-6.464276728675756, -6.464276728675756, -4.706418811123383, -4.066381455877386, -5.675819368311486, -2.9438159261867836, -5.994273099430021, -2.479003261508018,
+		-4.372412666997364, -6.464276728675756, -6.464276728675756, -5.270354260203322, -4.490195702653747, -5.399565991683328, -6.464276728675756, -4.741510130934653,
+		-6.464276728675756, -6.464276728675756, -6.368966548871431, -5.771129548115812, -4.63972743662471, -6.368966548871431, -6.464276728675756, -6.368966548871431,
+		-5.129275661943416, -6.368966548871431, -6.464276728675756, -4.815618103088375, -5.077982367555866, -5.933648477613586, -5.471024955665473, -6.127804492054544,
+		-6.464276728675756, -6.464276728675756, -2.532451095951431, -5.8764900637736375, -6.464276728675756, -6.368966548871431, -6.464276728675756, -4.057331620357468,
+		-6.464276728675756, -6.368966548871431, -6.464276728675756, -6.464276728675756, -6.464276728675756, -6.464276728675756, -6.464276728675756, -6.464276728675756,
+		-6.464276728675756, -6.464276728675756, -6.464276728675756, -6.464276728675756, -6.464276728675756, -6.464276728675756, -6.464276728675756, -6.201912464208266,
+		-6.464276728675756, -6.464276728675756, -1.924972745101048, -5.596197263976264, -5.596197263976264, -4.640685818948827, -4.209902902856373, -5.596197263976264,
+		-5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264, -4.433046454170583, -5.596197263976264, -5.596197263976264, -5.596197263976264,
+		-5.596197263976264, -4.161112738686941, -4.464795152485163, -5.596197263976264, -5.596197263976264, -3.8044377947482086, -5.596197263976264, -5.596197263976264,
+		-5.596197263976264, -4.006962058859683, -5.596197263976264, -5.0084105990741445, -5.596197263976264, -3.3554875747003052, -5.596197263976264, -5.596197263976264,
+		-4.1145927230520485, -5.596197263976264, -4.763288141041159, -5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264,
+		-5.596197263976264, -5.596197263976264, -5.596197263976264, -5.500887084171938, -5.596197263976264, -4.402274795503829, -5.596197263976264, -5.596197263976264,
+		-4.497584975308154, -4.3724218323541475, -5.333832999508773, -5.596197263976264, -3.4444350607168017, -5.596197263976264, -5.596197263976264, -5.596197263976264,
+		-5.596197263976264, -4.566577846795106, -5.596197263976264, -5.596197263976264, -5.596197263976264, -5.126193634730528, -5.596197263976264, -5.596197263976264,
+		-4.433046454170583, -4.954343377803869, -4.903050083416319, -5.333832999508773, -5.413875707182309, -5.596197263976264, -5.596197263976264, -1.2179276781800943,
+		-5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264, -4.343434295480896, -5.596197263976264,
+		-5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264, -5.596197263976264,
+		-5.596197263976264, -5.596197263976264, -5.596197263976264, -5.333832999508773, -5.596197263976264, -5.596197263976264, -5.596197263976264, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.352426164897591, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545,
+		-4.534747721691545, -4.534747721691545, -4.534747721691545, -4.534747721691545, -4.7140245909001735, -4.801035967889804, -4.896346147694128, -1.626777208510409,
+		-4.801035967889804, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.801035967889804, -4.896346147694128,
+		-4.801035967889804, -4.801035967889804, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.633981883226637,
+		-4.896346147694128, -4.896346147694128, -4.896346147694128, -4.7140245909001735, -4.896346147694128, -4.896346147694128, -4.896346147694128, -2.645054349087633,
+		-4.896346147694128, -4.896346147694128, -4.633981883226637, -4.896346147694128, -4.7140245909001735, -4.896346147694128, -4.896346147694128, -4.633981883226637,
+		-4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.154408802964751, -4.896346147694128, -4.896346147694128, -4.559873911072915,
+		-4.896346147694128, -4.7140245909001735, -4.559873911072915, -4.801035967889804, -4.7140245909001735, -4.896346147694128, -4.801035967889804, -4.896346147694128,
+		-4.896346147694128, -4.896346147694128, -4.896346147694128, -4.308559482792009, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.308559482792009,
+		-4.896346147694128, -4.896346147694128, -4.801035967889804, -4.633981883226637, -4.896346147694128, -4.7140245909001735, -4.7140245909001735, -4.896346147694128,
+		-4.896346147694128, -4.801035967889804, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128,
+		-4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128,
+		-4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128, -4.896346147694128,
+		-4.896346147694128,
+	},
+}
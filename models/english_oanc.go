@@ -0,0 +1,426 @@
+// Code generated by gibberdet.WriteGoSource; DO NOT EDIT.
+
+package models
+
+import (
+	"sync"
+
+	"github.com/shabbyrobe/gibberdet"
+)
+
+var (
+	englishOANCOnce  sync.Once
+	englishOANCModel *gibberdet.Model
+)
+
+// EnglishOANC returns the compiled-in model. It is built on the first call, and
+// is safe for concurrent use.
+func EnglishOANC() *gibberdet.Model {
+	englishOANCOnce.Do(func() {
+		m, err := gibberdet.NewModelFromData(&englishOANCData)
+		if err != nil {
+			panic(err)
+		}
+		englishOANCModel = m
+	})
+	return englishOANCModel
+}
+
+var englishOANCData = gibberdet.ModelData{
+	Alphabet: "a-zA-Z_\\-' ",
+	Storage:  gibberdet.StorageDense,
+	Gram: []float64{
+		-5.5759373314813026, -3.7342029724310484, -3.192150044605677, -3.4947946785142947, -6.357889722438012, -5.129747349233671, -3.9027092719767387, -4.604572948853964,
+		-3.5525982714369886, -6.674501063495927, -4.56279259018196, -2.236439389925091, -3.650727908476162, -1.6717173427874614, -7.4835030598071635, -4.052480135564075,
+		-7.655864569416254, -2.3279601011693605, -2.4848330791550097, -1.8333379687965539, -4.434969796820793, -3.826176052412634, -5.340880947537344, -6.207415014729714,
+		-3.635285227646205, -6.292966537445478, -8.910183353083482, -8.97345111068434, -7.203702801557636, -10.003360845765636, -9.820023544851846, -9.72179500033129,
+		-9.932743278551682, -9.709599727237473, -8.900691756401912, -10.883719568413728, -10.781304562985659, -9.405015843983088, -8.236293989127953, -9.906573560818298,
+		-9.73414083615359, -9.633761650845967, -12.141396551711525, -9.955732796776381, -9.316329892223127, -8.761105854868683, -11.65854477998794, -11.289184676521892,
+		-9.450738093321183, -11.465641113863448, -10.873963393468363, -12.012184820231518, -3.305531471156762, -6.865483469698901, -7.32809143410355, -3.400306988972906,
+		-2.4301132645111996, -4.978235653082064, -6.609251429518515, -6.925402281021073, -1.2774861025238398, -7.860011593418913, -7.991544184658399, -7.515171107127183,
+		-2.5518964439145764, -4.853829903990581, -10.518871550330351, -2.080764857234898, -6.333468257161284, -7.069519126781149, -2.13905714326753, -6.3975605398784365,
+		-12.128309462764452, -3.068954784472371, -3.5854485245996366, -4.876113777511925, -2.2078959656387305, -6.208340917361539, -8.200413108180015, -11.172798017737016,
+		-2.5098275674766963, -10.272011472398825, -9.433682281994383, -10.793308396032112, -11.252840725410552, -11.172798017737016, -9.786503656617125, -10.47965083717707,
+		-12.639135086530443, -10.518871550330351, -11.098690045583293, -13.737747375198552, -13.737747375198552, 5e-324, -9.40701403491222, -11.658305833518716,
+		5e-324, -10.742015101644562, 5e-324, -9.748763328634277, -11.540522797862332, -11.098690045583293, -10.742015101644562, -12.128309462764452,
+		-12.639135086530443, 5e-324, -13.737747375198552, -10.847375617302387, -4.962120711689657, -7.083594855015333, -8.572961401275037, -5.08462566655807,
+		-1.9691554100383277, -9.401985485878054, -4.064871704323261, -8.297508077757547, -1.8499829024058871, -9.625129037192263, -9.181197648256303, -2.1376546796635676,
+		-2.7589006579804662, -12.591873773873003, -3.3764463628482626, -3.19507754596441, -8.199189844725133, -8.973263515605622, -1.744035729780431, -10.037974252598051,
+		-6.796250333646488, -3.156711260204055, -5.135047908586704, -2.1729825215425076, -3.2621262889428153, -11.64741216503215, -11.829733721826106, -13.151489561808425,
+		-4.278266634555444, -9.280288550900535, -8.533896855821776, -9.413819943525057, -7.05961422876853, -6.708551385384723, -10.410649537883224, -9.384492328430536,
+		-8.878301707168694, -11.898726593313057, -9.444033722121553, -10.776583807234754, -9.200245843226998, -8.817472146320904, -9.144156376575955, -9.26478436436457,
+		-10.84890446881438, -8.946796942417459, -10.982435861438901, -8.473998714240707, -10.776583807234754, -9.792851794565065, 5e-324, -10.233718829724145,
+		-12.928346010494215, 5e-324, -12.23519882993427, -9.328297770086895, -4.0299530815077365, -7.270956575407725, -8.44421415288318, -4.154002121663375,
+		-3.1784594968604494, -7.452868582398453, -7.338317370561851, -4.4754716586885, -1.883101253849196, -7.4884919020094705, -5.238663161454736, -6.693810962940325,
+		-2.190062659003788, -6.700104690009018, -9.130076839789906, -4.917329690623737, -5.533583557708102, -5.076319129306897, -2.8311935079256196, -7.888186653656298,
+		-8.67411556766721, -4.000578969002781, -3.618062793880161, -7.595053412921889, -3.5458917948118516, -5.612070779251584, -6.609941498118162, -10.917860160638321,
+		-4.297501280630805, -9.644894484825434, -10.465875036895264, -11.436653954053488, -9.217450469998495, -12.458305201585471, -11.793328897992222, -11.611007341198267,
+		-10.685237865369569, -11.477475948573744, -10.286081926454669, -12.17062312913369, 5e-324, -9.928141960209349, -12.458305201585471, -9.561779578114928,
+		-13.962382598361744, -10.64819659368922, -12.863770309693635, -10.826888382432594, -11.221542574436544, -9.367262748227155, -9.473746228629604, -12.352944685927644,
+		-11.71109079975525, -14.65552977892169, -14.65552977892169, 5e-324, -1.3530739940984227, -5.39782883558567, -6.464066727594763, -1.435609656223015,
+		-2.9535512779511928, -6.132267150395729, -3.435815548212107, -2.6107368979517296, -3.751491588947255, -4.690209412115957, -4.841237848998881, -6.544274337911324,
+		-4.50394715076538, -8.43229380496667, -6.3196550827349185, -3.2632659693925614, -3.859602417778118, -2.355833679131419, -5.226370257118241, -4.373423980445754,
+		-5.441860096896416, -2.0629677411633094, -2.3797674835373748, -3.509954632123342, -6.28128817446885, -4.052061752880177, -4.732211369445895, -4.251858569508725,
+		-4.3826826062183875, -8.190509741536458, -11.579470396750176, -10.555659321164764, -10.115214494160574, -13.05032214589813, -12.939096510787905, -11.016001039498764,
+		-11.514087637487325, -10.771547701597804, -10.65242687309976, -13.175485288852137, -15.1903883093944, -10.33057590503273, -11.649428985357087, -11.606869370938291,
+		-12.625438951932864, -11.38372581962408, -12.939096510787905, -12.482338108292192, -10.626040117926564, -11.104411996842817, -12.993163732058182, -11.552802149668015,
+		-12.122335374260784, -12.076873000184026, -13.686310912618127, -11.201404262830126, -1.8663179100633738, -5.68313361365286, -5.45736291513978, -1.9578485313443894,
+		-2.9402222578421275, -9.72193829003874, -9.099107607896254, -10.650651541911452, -2.4713203023234334, -2.7627625642594356, -7.504346409878087, -9.72193829003874,
+		-2.2271030429938112, -12.506949532277078, -9.022637243904416, -3.917845065393857, -8.186798301321284, -9.132780823002843, -1.7573405253337113, -10.897511619842978,
+		-12.730093083591289, -2.7105128120683064, -6.1329473817046365, -3.57296890622243, -3.3406446458906522, 5e-324, -8.579053177692643, -9.709668197446925,
+		-5.370784592308231, -9.785654104424848, -10.224567146600553, -12.730093083591289, -12.324627975483123, -12.324627975483123, -13.017775156043069, -13.017775156043069,
+		-13.423240264151234, -11.551438087249641, -11.813802351717133, -14.11638744471118, -14.11638744471118, -14.11638744471118, -9.973252718319646, -14.11638744471118,
+		-14.11638744471118, -9.320596899114438, 5e-324, -12.170477295655866, -10.682400240226032, -10.897511619842978, -14.11638744471118, 5e-324,
+		-11.47733011509592, 5e-324, 5e-324, 5e-324, -1.6837969472278458, -5.597395871353561, -8.467413206549972, -1.7659677323990701,
+		-2.8232044441265667, -8.894458983615117, -9.13667868199014, -7.371187139582696, -1.7090191153088083, -7.663527276776983, -4.333082742780547, -2.31660571209295,
+		-2.9675949750716226, -10.45843452197246, -8.666675052744404, -3.8162012657104247, -5.437482260905272, -3.452620319194018, -2.6486790247289402, -7.887095366412152,
+		-10.640756078766413, -2.683018688084234, -3.7319846495731537, -4.976754474122058, -3.1817052434645734, -11.151581702532406, -8.462223634442347, -10.74611659442424,
+		-4.700935764810771, -8.912054745505497, -9.44683361029398, -11.046221186874579, -8.462223634442347, -11.046221186874579, -9.635234213164317, -12.655659099308679,
+		-7.748534181582088, -12.655659099308679, -12.43251554799447, -14.04195346042857, -12.94334117176046, -12.43251554799447, -9.779273583387255, -12.250193991200515,
+		-12.250193991200515, -11.208740116372354, -14.04195346042857, -10.74611659442424, -10.404367300702184, -10.950911007070253, 5e-324, -12.94334117176046,
+		-12.94334117176046, -12.94334117176046, -12.94334117176046, -14.04195346042857, -1.820674518881252, -5.714710852970778, -6.927184012062106, -1.9085563519388704,
+		-1.7581437284603654, -7.108404351735771, -8.667803614544694, -7.747484311025441, -0.8424615631779069, -8.588466872308171, -10.841455024162846, -8.647821114957354,
+		-2.263200114819744, -11.87754695584962, -9.621481878490469, -6.068995049282087, -6.05571212153864, -5.528487433615969, -2.5923015268788565, -8.67332340776829,
+		-9.439160321696514, -4.322426688962667, -6.165615952629515, -3.788482862361019, -3.9011544892024372, -10.30515031509587, -6.639413967130535, -13.582295048088046,
+		-4.672654445644948, -12.024150430041496, -9.675284584483444, -10.232390960813442, -9.832790972157675, -10.20641547441018, -10.314629059050414, -13.359151496773837,
+		-13.582295048088046, -12.329532079592678, -10.7942021393123, 5e-324, -12.196000686968155, -12.483682759419937, -11.790535578859991, -12.135376065151721,
+		-10.763896789816972, -10.4042412177401, 5e-324, -9.826925852705276, -13.176829939979882, -10.720094167158578, -14.968589409207937, -14.968589409207937,
+		-14.275442228647991, -14.968589409207937, -14.275442228647991, 5e-324, -2.6442738337889455, -4.957818191452134, -7.551009006793393, -2.8228295591170967,
+		-3.5134397771781276, -4.473123270716709, -2.6386100606797913, -3.334103067519188, -3.284463302119866, -3.7912325152814996, -3.4320053859588087, -7.961266536200926,
+		-8.064138297822176, -7.879588505186659, -4.629472855496189, -3.130283564017765, -3.4311620064376664, -1.3529152038618866, -2.5128946026046726, -4.733938892219594,
+		-6.875191782771019, -3.619621725541497, -2.2757249417804153, -2.1212887390232242, -6.3253843659341165, -3.534861272115161, -9.386850618030453, -5.992775830602796,
+		-9.567260128352782, -4.814930488348242, -12.714281849452998, -12.377809612831786, -10.286533613504947, -14.3237197618871, -13.342890508875373, -11.838813112099098,
+		-10.13912832181722, -12.203456225687008, -12.426599777001218, -15.422332050555209, -14.036037689435318, -13.630572581327154, -11.661131934861647, -10.900543473506168,
+		-11.784745890828823, -12.531960292659043, -13.342890508875373, -9.58169039318181, -11.510309045127062, -11.956596147755482, -14.729184869995263, -11.015612803290956,
+		5e-324, -13.81289413812111, -13.630572581327154, -13.81289413812111, -4.324270726538989, -6.820797710705221, -5.4036425683303975, -4.34653265392134,
+		-2.859597920463878, -9.029417836095936, -9.386092780034668, -8.11312710422178, -1.771992087327949, 5e-324, -8.767053571628445, -9.386092780034668,
+		-4.061690043010956, -9.386092780034668, -7.22112906491667, -9.252561387410145, -7.027937835885812, -8.196508713160831, -1.4630550628285977, -7.157615659194344,
+		5e-324, -8.196508713160831, -8.62395272798777, -8.240960475731665, -0.6704235073535256, -11.332002929089981, 5e-324, 5e-324,
+		-9.94570856797009, -9.722565016655881, 5e-324, 5e-324, -11.332002929089981, 5e-324, 5e-324, -11.332002929089981,
+		-11.332002929089981, 5e-324, 5e-324, 5e-324, 5e-324, 5e-324, 5e-324, 5e-324,
+		5e-324, 5e-324, 5e-324, 5e-324, -11.332002929089981, 5e-324, -11.332002929089981, 5e-324,
+		5e-324, 5e-324, 5e-324, 5e-324, -5.532910274629455, -9.029417836095936, -9.94570856797009, -5.5574513835455726,
+		-3.4855375043193226, -5.925122508567876, -8.23440779413174, -6.80897352467458, -1.1928417497885733, -6.369741554095599, -5.183503204709432, -6.075246803226311,
+		-1.9646910001552182, -9.121710989132644, -7.13299830084257, -4.366187411361988, -5.604394843323143, -1.9318042092769687, -5.116978585600231, -6.25543678281554,
+		-10.30548108614106, -6.600072330075914, -2.8087176663401237, -6.337708065608423, -5.836512521333371, -8.226039544461225, -6.322689009824708, -10.52862463745527,
+		-4.463096762047232, -11.62723692612338, -8.388558473959, -8.40836110125518, -10.816306709907051, -6.83558717319267, -12.320384106683326, -12.320384106683326,
+		-11.40409337480917, -9.75543474922179, -12.320384106683326, -13.013531287243271, 5e-324, 5e-324, -11.91491899857516, 5e-324,
+		-11.221771818015215, -9.79465546237507, 5e-324, -12.320384106683326, -11.067621138187958, -10.448581929781733, -11.91491899857516, -10.374473957628012,
+		-10.180317943187054, 5e-324, -11.91491899857516, 5e-324, -2.0349901184493135, -5.198324225054183, -5.726654875492572, -2.1139164702582303,
+		-2.319616204779789, -6.600902515810078, -5.869944901758466, -3.108138616663764, -1.8570338068636314, -4.852290695688287, -6.5973932666965505, -8.455147450184153,
+		-2.054826889681476, -10.809116742524678, -5.355195207521399, -2.025901648298706, -5.4052116134140755, -7.2444614783797086, -2.6779122883448205, -5.535185217444267,
+		-11.558353389735666, -6.575537386068249, -3.3902704204579193, -3.7305299071332874, -3.552627053271226, -5.288674525179837, -6.053647440311038, -10.626795185730723,
+		-2.3769864144612156, -8.97580827038375, -9.86769003737898, -11.178863768030762, -12.331543277969148, -12.61922535042093, -11.205532015112924, -12.418554654958777,
+		-11.520613061752819, -12.331543277969148, -11.07878030947378, -14.123302747197203, -13.717837639089039, -14.123302747197203, -12.043861205517366, -12.108399726654937,
+		-12.108399726654937, -11.983236583700933, -12.737008386077312, -13.207012015323048, -11.205532015112924, -11.597574102888947, -14.123302747197203, -13.717837639089039,
+		-11.597574102888947, -11.872010948590708, 5e-324, 5e-324, -2.526141837909119, -5.710803626895632, -7.1049009481280025, -2.62684462776419,
+		-1.8339925293162798, -3.5203433796332604, -8.77223364144492, -8.93523092541247, -1.3673337026429, -7.029772595609436, -7.138698177431759, -8.756104259515036,
+		-2.2519478311833545, -11.638507847762023, -9.94683183709095, -5.9023964859904625, -3.540864200384368, -5.86840273017475, -2.2007701275650815, -2.562343974405682,
+		-11.23304273965386, -8.54099287989809, -3.664835662949919, -8.129096881459635, -3.321195187879219, -9.253684656531005, -8.361363114769848, -11.569514976275073,
+		-3.8415100789567598, -9.800228362899077, -8.705411145199518, -9.595433950253062, -9.300831434956708, -12.485805708149227, -12.891270816257391, -13.178952888709173,
+		-9.41775277301561, -9.130070700563829, -10.150430792332191, -11.387193419481118, -12.198123635697446, -8.499912854154626, -6.398273692295012, -10.217122166830864,
+		-11.444351833321067, -11.281832903823291, 5e-324, -6.652946191217884, -11.504976455137502, -10.876367795715128, -11.974980084383237, -9.541366728982787,
+		-11.0194686393558, 5e-324, -14.277565177377282, 5e-324, -2.6771234628870113, -4.504300211891128, -6.980474172216865, -2.858796557557555,
+		-3.229549201928494, -7.507145166608237, -3.101238302011321, -1.915429137269225, -2.5741545785510556, -4.874740773233527, -2.173696230913748, -6.400081940301316,
+		-3.2882165327734776, -6.522943229117872, -4.633870468389292, -5.076704767366418, -5.699036206499396, -4.749353991678567, -2.8625245490591964, -7.168213866544767,
+		-7.807837475796033, -7.04668556612203, -2.870879097673749, -2.126505310348521, -4.6849133062748765, -5.147873982642906, -7.615425901396225, -8.855394593663858,
+		-4.4685342604580915, -7.166540692702114, -10.302313576600184, -9.064311023687937, -11.502021982139663, -12.579580861609939, -11.614499965566353, -13.154945006513502,
+		-12.86726293406172, -13.96587522272983, -11.162514841823295, -12.35643731029573, -15.35216958384972, -11.796821522360307, -9.01888995571003, -12.21667536792057,
+		-12.713112254234462, -11.82580905923356, -13.560410114621666, -9.795341522150183, -11.274632139944002, -11.19328650049005, -12.95427431105135, -13.272728042169884,
+		-12.95427431105135, -13.154945006513502, -14.253557295181611, -13.560410114621666, -2.165270185310946, -5.53833325326624, -4.2434150647769435, -2.2524486221264515,
+		-5.186218142957913, -4.473448908840607, -4.126803428580869, -3.91031434035277, -5.352065557000282, -2.3502772436140784, -4.479772145516907, -5.229389273146147,
+		-4.657664981665843, -7.383832383594552, -4.830627548002278, -3.2629406991515673, -2.8390020624572534, -1.736245775971064, -3.805170388247551, -3.781196396379408,
+		-8.659169674128458, -2.022668974856521, -3.403906386096604, -3.0251264355256353, -2.286335064647536, -3.9767530995918303, -3.1851163150313107, -5.815070324149016,
+		-5.756131143223983, -7.383513556874172, -9.854805939078627, -11.117047651528539, -10.80956295178058, -10.968627646410265, -12.94962911527685, -13.03664049226648,
+		-11.823617852420625, -10.859824786561468, -11.157869646048795, -13.237311187728631, -14.741388584504906, -11.323661900891539, -10.136218398516814, -12.94962911527685,
+		-13.488625616009537, -12.256481934716904, -10.725005563752516, -9.710950663112468, -9.423268590660689, -10.957198950586644, 5e-324, -11.427202579832379,
+		-12.795478435449592, -14.741388584504906, -13.82509785263075, 5e-324, -2.874298323382545, -6.314010697411032, -7.643012945914118, -2.95604190385095,
+		-2.133270906264702, -8.097147798276525, -7.909095566773585, -7.36890929790531, -1.7651639720954995, -7.535423046466281, -8.37665321029106, -3.2724069385696515,
+		-3.083495712805185, -12.024059416198421, -8.403057384487571, -2.2476739685219123, -5.381246722698576, -7.3653484632823005, -2.052463549947908, -3.0428335110262266,
+		-13.410353777318312, -1.6972054444184423, -3.9296809712254612, -3.301397965147983, -3.2237382565389625, -11.213129199982093, -8.444018742118637, -12.157590808822944,
+		-5.3633242279491755, -12.157590808822944, -8.42332834886119, -8.944445658663728, -8.77078216461289, -10.342300842184695, -8.214622999545377, -9.508381107743668,
+		-8.888565200269271, -6.974203408948885, -10.319311323959996, -9.307710412281516, -9.883993252702151, -10.577140433262096, -9.198226179439828, -9.614864588146117,
+		-12.157590808822944, -10.043057947331839, -13.410353777318312, -8.31660357651155, -9.468771969648621, -9.367302509483762, -10.669513753393112, -10.21168065976763,
+		-13.004888669210148, -10.2533533561682, -10.968006741949107, -13.410353777318312, -3.6900231303828215, -6.271486777372789, -7.9593153237526115, -3.7780189952827543,
+		-6.480373167001082, -9.313586511057297, 5e-324, -8.956911567118565, -7.73313613549645, 5e-324, 5e-324, 5e-324,
+		-5.087796062701695, 5e-324, 5e-324, -9.87320229899272, -8.77459001032461, -9.062272082776392, -9.87320229899272, -11.25949666011261,
+		-9.65005874767851, -8.956911567118565, -7.16515209789051, -9.062272082776392, -0.0283639768939313, -10.566349479552665, 5e-324, -11.25949666011261,
+		-11.25949666011261, 5e-324, 5e-324, 5e-324, 5e-324, -10.160884371444501, -10.566349479552665, 5e-324,
+		5e-324, -10.566349479552665, 5e-324, 5e-324, 5e-324, -11.25949666011261, -9.180055118432774, 5e-324,
+		5e-324, -8.86160138731424, 5e-324, -9.467737190884556, 5e-324, 5e-324, 5e-324, 5e-324,
+		5e-324, 5e-324, 5e-324, 5e-324, -4.78715036561171, -7.7629890986461305, -6.6443761432713515, -4.880221940135185,
+		-2.415145001113399, -5.626138007176798, -4.32149304547319, -3.8583551734675847, -1.3568106079629787, -5.422952913700453, -4.362997335415381, -6.3314704669507265,
+		-2.3704470145219663, -9.230772141598596, -4.326230656645486, -4.649825277293415, -3.8621589333536495, -4.031814811130166, -2.3199408691720658, -5.355240244752865,
+		-9.096915281111151, -4.143593846407672, -2.9843853160158194, -3.1056614623551706, -4.150662610408923, -4.5631897168474715, -6.578227309603224, -9.667985948021341,
+		-3.4623534943228846, -9.141159982908908, -10.471884492873988, -10.419240759388567, -9.994028888345568, -10.918734817145006, -11.655054169570162, -12.783519421387952,
+		-9.829556560710257, -12.878829601192278, -11.138363426351772, -14.082802405518214, -13.795120333066432, -10.77469544692207, -11.174081508953853, -12.003360863838378,
+		-12.348201350130108, -10.59644721551575, -13.571976781752223, -8.821840825513945, -10.77469544692207, -11.543828534459937, -15.181414694186323, -10.659626117137282,
+		-14.082802405518214, -13.23550454513101, -13.795120333066432, 5e-324, -2.46392360064518, -5.9447245468873575, -6.191845689379294, -2.5578726149764135,
+		-3.6700185423021114, -6.818417178391974, -3.985418150667483, -6.7379542143558755, -2.107443951723879, -6.234635690077658, -7.84648952332572, -3.4062975733211993,
+		-2.6022634252993946, -10.62098968291077, -5.2252771132153075, -4.97511019694067, -4.784181896048092, -5.623363815703107, -3.014861107414945, -3.716945362659129,
+		-6.912657802870017, -7.085963333856235, -3.008057826740328, -1.9983780183326, -3.2850564083096807, -9.094489822078662, -5.997518596894754, -11.33475615067345,
+		-5.062626278508589, -9.74212535655573, -9.767877852658145, -11.947860623559858, -9.733686487909866, -11.062822435189808, -11.293934156153195, -11.910120295577011,
+		-12.2615181824149, -10.112206960774579, -12.027903331233395, -15.20595716158134, -13.414197692353286, -10.561566262439968, -12.21022488802735, -10.195321867485085,
+		-12.372743817525125, -11.804759779919186, -13.59651924914724, -10.052665567083562, -11.98708133671314, -11.771969957096195, -14.107344872913231, -14.512809981021395,
+		-14.512809981021395, 5e-324, 5e-324, -10.330759838380189, -1.5997797096578712, -5.941981240439248, -6.277714483763038, -1.6884383047951,
+		-3.035991921642199, -7.838549063823419, -5.845964762490774, -9.10625999375466, -2.222780433853384, -7.7595177259896095, -8.955557593206317, -1.2939171382077816,
+		-2.1490334859652407, -11.327876024215039, -9.42039325229361, -4.783264982697075, -5.981099236017536, -7.154129985505207, -2.400790502281232, -7.859972333876598,
+		-14.505929854562984, -3.321980790319041, -3.4436159333193954, -4.252501649278511, -3.929906075371035, -10.91319426100195, -4.950889283912697, -10.721740220644723,
+		-3.953098987907172, -7.773321928626801, -10.931713308769188, -11.273808802944762, -11.055942308731396, -10.71419301500934, -12.513499689872777, -11.733341132323202,
+		-11.634250229678972, 5e-324, -11.493668279057783, -14.911394962671148, -12.426488312883148, -13.206646870432722, -11.161890886740776, -12.27233763305589,
+		-10.670068210100402, -10.729344820029942, 5e-324, -10.422758592939008, -11.461407416839561, -11.356046901181735, -14.505929854562984, -13.65863199417578,
+		-13.407317565894875, -13.995104230796994, 5e-324, -14.911394962671148, -2.2432973075990175, -6.028170732392153, -4.500111370929394, -2.3265674389782216,
+		-3.4544755197147987, -3.702283584981532, -3.084975443846338, -3.568913588608746, -3.1260706763183657, -5.133632989890035, -3.418930976020279, -3.005983235256427,
+		-3.7462795156264, -8.717817043102956, -6.689826387805324, -2.424093428577408, -2.7643404363908024, -2.416267929447902, -5.617832652368979, -3.393770650737064,
+		-9.416970247723114, -2.0745303369012285, -2.052064675173114, -2.1358333733798816, -8.96827640506413, -7.421532561968952, -8.945461727297957, -7.085294352964066,
+		-5.769289365049717, -7.290461195185292, -11.584519056913216, -12.331733458743438, -12.131062763281285, -13.835810855519712, -14.528958036079656, -13.142663674959767,
+		-10.434613473857556, -12.449516494399822, -11.35090420573171, -12.131062763281285, -13.142663674959767, -12.226372943085611, -11.161662206093183, -13.835810855519712,
+		-12.583047887024343, -9.82847767028724, 5e-324, -9.220690338678452, -10.84007858196572, -12.919520123645556, -14.528958036079656, -11.96400867861812,
+		-13.142663674959767, -14.528958036079656, -11.032450474613176, -14.528958036079656, -3.6308492782634185, -8.651222254300018, -5.426648408317179, -3.6668580822239045,
+		-2.2817009916265305, -11.507591263379526, -10.96859476264684, -9.541478407006693, -0.4433732384068533, -12.067207051314949, -10.086205582448367, -12.35488912376673,
+		-1.6012506418609922, -13.45350141243484, -11.150916319440794, -9.059052257762401, -10.888552054973303, -10.563129654538676, -3.0991611953273726, -12.35488912376673,
+		5e-324, -7.545418474265909, -6.35264950349079, -10.620288068378624, -6.6411563182573605, -8.641317057062423, -12.35488912376673, -13.45350141243484,
+		-5.938156841254404, -12.760354231874894, -10.509062433268399, -11.661741943206785, -11.150916319440794, -11.84406350000074, -10.620288068378624, 5e-324,
+		-13.45350141243484, -12.760354231874894, -11.84406350000074, -10.96859476264684, 5e-324, 5e-324, -13.45350141243484, 5e-324,
+		-12.35488912376673, -13.45350141243484, 5e-324, -13.45350141243484, -12.760354231874894, -9.541478407006693, 5e-324, -13.45350141243484,
+		-11.507591263379526, -13.45350141243484, 5e-324, 5e-324, -6.848203491486638, -8.593689008073168, -8.8996245208343, -6.962777877932332,
+		-1.7985781255652324, -7.2079588169233, -8.317870660845344, -6.518563985981905, -1.5759866264127382, -7.0694460939485735, -9.86846807325651, -1.9257822510238527,
+		-1.8345258711716377, -11.814378222311824, -7.2487287944860155, -5.419116624196374, -8.047380988933934, -3.2813362883412482, -2.273916092531267, -8.684333611150238,
+		-13.200672583431714, -4.438496074096811, -3.8574197603357896, -5.598271247765895, -8.863381842599225, -10.59798289798733, -6.299438521439843, -13.89381976399166,
+		-6.157076081538164, -12.507525402871769, -13.200672583431714, -13.89381976399166, -13.89381976399166, -13.89381976399166, -13.200672583431714, -13.89381976399166,
+		-12.284381851557558, -12.795207475323549, -12.795207475323549, -12.507525402871769, 5e-324, -13.89381976399166, -12.507525402871769, -12.795207475323549,
+		5e-324, -13.89381976399166, 5e-324, -13.200672583431714, -12.795207475323549, -12.507525402871769, -13.200672583431714, -13.89381976399166,
+		-13.200672583431714, 5e-324, 5e-324, 5e-324, -2.699234911538116, -6.016801868369261, -7.632328079670617, -2.7849379179242635,
+		-2.1864936448894396, -8.56083680143279, -2.6488665013192927, -10.352596270660845, -2.6634082462514024, -6.184381859872289, -9.6594490901009, -4.19181097893616,
+		-2.1018195427922843, -10.17027471386689, -9.6594490901009, -6.678830454356957, -7.936682492359797, -8.155371693324627, -3.659024801621446, -1.2680912675318743,
+		-6.725592220264996, -9.6594490901009, -8.435673658478784, -2.0610497607769362, -4.059546745932091, -8.177844549176685, -7.4622245127646805, -6.001028843471672,
+		-4.268096857585674, 5e-324, -7.555314935830693, -8.703937645073463, -9.6594490901009, -8.666197317090617, -11.962034183094946, -10.352596270660845,
+		-8.87099172973663, -9.764809605758726, -11.268887002535001, 5e-324, -11.962034183094946, -9.6594490901009, -10.352596270660845, -9.071662425198781,
+		-11.962034183094946, -8.03020855037062, 5e-324, -9.189445460855165, -8.703937645073463, -9.764809605758726, 5e-324, 5e-324,
+		-11.962034183094946, 5e-324, 5e-324, -11.268887002535001, -2.7496459095014627, -4.669016503322164, -6.352562387909986, -2.849416899647378,
+		-4.789245980473117, -4.792577181503646, -4.78480459174948, -5.794014314071562, -2.4570300608684232, -7.726598335880744, -6.384711863250294, -8.05767972386708,
+		-4.1511556810260215, -11.578678305341857, -7.88979885122792, -4.501333696609507, -4.384651038519212, -4.814478518792039, -2.113237750810978, -4.062245002426224,
+		-11.779349000804007, -5.693584885397624, -2.913182670576394, -4.194632383683595, -7.586332911074877, -8.564927526285187, -5.648605719834738, -9.882229015918126,
+		-9.933522310305676, -6.021148489227555, -9.53392232164991, -9.231641449776976, -8.829079101326775, -10.841079362211078, -11.086201820244062, -13.976573578140227,
+		-11.491666928352227, -13.976573578140227, -11.67398848514618, -12.590279217020337, -12.590279217020337, -12.590279217020337, -12.590279217020337, -11.491666928352227,
+		-10.980841304586235, -10.338987418413842, -11.897132036460391, -10.609277748153753, -10.0847532800296, -10.450213053524065, 5e-324, -12.184814108912171,
+		-12.184814108912171, 5e-324, -13.976573578140227, 5e-324, -1.1693100623676091, -5.0520503520063125, -4.020066721628463, -1.2622837077606308,
+		-1.4967736641100728, -6.19608627217168, -7.2614729132114695, -7.082424681762484, -0.8699500050077705, -8.554241216320536, -7.1859653607033245, -5.027880691704375,
+		-2.121186293243056, -11.193298545935795, -6.539338195778272, -4.541726672346068, -4.960850529385272, -6.258824612805103, -2.9823588125567744, -7.167946855200646,
+		-7.79210116427364, -7.826002715949321, -6.529859451823728, -6.01151499564371, -5.03631956035024, -7.00364380390937, -6.944803303886436, -11.193298545935795,
+		-2.9060217901211964, -3.3992994564318, -10.50015136537585, 5e-324, -10.50015136537585, 5e-324, 5e-324, 5e-324,
+		5e-324, 5e-324, 5e-324, 5e-324, 5e-324, -11.193298545935795, 5e-324, -11.193298545935795,
+		5e-324, 5e-324, 5e-324, 5e-324, -9.113857004255959, 5e-324, -11.193298545935795, -9.583860633501695,
+		5e-324, 5e-324, 5e-324, -11.193298545935795, -3.7216509222509755, -5.6961303206425935, -5.652035000777369, -3.854735351173699,
+		-6.137745835016078, -4.2676172986725165, -3.3738907927450517, -3.592555627535004, -6.469879670038694, -3.5646358860098135, -4.478689943795736, -6.267557446538069,
+		-4.575946576081331, -8.03486581990196, -6.8890749709587595, -2.5250668417875564, -2.633756450895049, -2.2311287194640124, -9.177929871140904, -3.963735495006627,
+		-6.975667499774727, -3.1598897845972633, -2.7877654729998174, -3.5003980403575325, -3.807080448868071, -5.171423473884427, -6.245524731538186, -7.011476952471438,
+		-7.006282135594334, -6.27501089719265, -4.189044553740944, -4.7230541732898965, -3.958803302517308, -4.705252656084853, -6.093105054393458, -5.185584476873924,
+		-4.216675824960572, -6.318329771911492, -4.631737935709746, -7.615011974341695, -6.032602734295515, -4.66856998999882, -4.698013741330209, -4.574579521869798,
+		-4.171850393580509, -4.073086933184423, -6.689242498512997, -4.274002801801342, -4.281787576265747, -3.1692283738650633, -6.448889394146858, -6.7841753910085645,
+		-6.813651209141518, -7.306127694239311, -6.342046298528809, -7.393775001298068, -2.360099299686753, -4.6298111528400465, -5.5982060036533445, -2.4760253476624596,
+		-2.113728142454034, -9.106437127316335, -6.937383426946812, -9.79958430787628, -2.1058747987479913, -8.75813043304812, -7.530900766557916, -6.803852034322289,
+		-2.629977716476884, -9.645433628049021, -10.89819659654439, -3.489363045251142, -7.069555200055295, -8.500301323746019, -2.2626871783101103, -10.492731488436226,
+		-10.205049415984444, -2.1579398570141133, -6.220705748976672, -5.640701224516608, -1.6577635842610379, -10.205049415984444, -8.818755054864553, -8.818755054864553,
+		-3.765699044884346, -11.591343777104335, -4.119411698859213, -5.750702119730937, -4.087503030405384, -5.118997482603435, -5.58253059166174, -6.600911190325599,
+		-6.140305323538635, -6.803852034322289, -3.9185858804618245, -6.479355988747792, -6.656869843973643, -4.3147873743856255, -3.9964593898878156, -7.209317142430454,
+		-5.421733044612879, -4.2036345380232945, -9.026394419642799, -4.9752785919715175, -3.811040689195962, -5.907764009765653, -6.671362851276211, -6.153264468181139,
+		-8.41328994675639, -6.467379797701076, -6.202272047287834, -7.273855663568025, -3.8980900564980665, -4.9294890365590245, -7.1369964808508275, -3.999986730405784,
+		-2.0704598655453754, -8.826135651644307, -8.461492538056397, -5.816500472921325, -3.4515982591848613, -8.826135651644307, -10.099101327457195, -2.132811983374358,
+		-3.7632874861011167, -12.045011476512508, -12.045011476512508, -2.2868387551412384, -8.113185843788182, -10.099101327457195, -1.6049720756395085, -7.001586359593261,
+		-12.045011476512508, -3.612505093263464, -5.123353292361379, -8.381449830382861, -3.9173116236947356, -8.153191178401881, -9.965569934832672, 5e-324,
+		-4.80795245038777, -7.1033690539032035, -3.442558441145447, -5.154402356365341, -3.956449671236274, -3.6786411748308536, -4.535128415357594, -5.047415493530581,
+		-4.760876670317303, -5.156439016947145, -3.9247201625439465, -8.518650951896346, -5.6923820801929415, -4.961623628887213, -4.987113539100651, -5.136256697197287,
+		-4.156301952330494, -4.918924203213383, -8.548503915046027, -3.6581547868242734, -4.916515530832471, -4.040311965962958, -5.871225372610571, -5.586673193167718,
+		-7.154662348290754, -6.292438837686874, -6.620061459031104, -9.647116203714138, -3.281348908478564, -4.177905976195769, -5.681983372972042, -3.3995130249433463,
+		-2.0571152258144263, -7.77669813941581, -8.96232180507355, -7.926229873386774, -1.6216631325285198, -7.926229873386774, -8.58762835563214, -7.003508251182328,
+		-2.1716111980417434, -8.224722861942771, -10.667069897311976, -9.057631984877874, -7.468396779761294, -7.749299165227696, -2.4027065675803074, -7.77669813941581,
+		5e-324, -3.2348819911576303, -5.259898125851856, -7.696655431742274, -3.1419691511861743, -8.87531042808392, -6.978190443198039, -8.65216687676971,
+		-4.827882785649571, -8.96232180507355, -3.918896688154303, -5.373765072587482, -4.483952465981154, -5.055768275449939, -4.429722311920294, -5.29179148962781,
+		-5.341623863375686, -4.597487570940041, -4.763071375985269, -7.029483737585589, -6.145281320262935, -5.041248964125486, -4.597487570940041, -2.4045109243013183,
+		-4.010986253258586, -4.51433720260787, -7.056151984667751, -4.573500127266839, -3.6393118259351414, -4.775425685486204, -6.161720046606095, -6.2422232654551655,
+		-7.170562335845495, -7.3712330313076455, -7.622547459588552, -7.722630918145534, -3.2374006975784266, -4.123877735117577, -5.502283923388461, -3.3496915000730096,
+		-2.8028177419985334, -5.335917993058251, -4.050658257649064, -3.357111476684201, -8.409768045876195, -4.819328664575511, -4.491514490697412, -6.660568191066935,
+		-4.438297581804628, -8.87977167512193, -7.748369563630829, -2.9872990772208983, -3.699674939961324, -2.2303985995467115, -8.543299438500718, -5.111619040113485,
+		-4.959780500044607, -4.456123365757229, -3.6406736682338643, -4.809036978538963, -3.0828024857396112, -2.7687478929563083, -7.039222041724443, -2.686795876826852,
+		-5.844818688414658, -7.748369563630829, -4.35256303060355, -4.401299142179796, -3.4870536331524087, -3.7127025951838473, -4.8834075212600325, -4.773827977056477,
+		-3.897535855547372, -6.61800857664814, -5.263462913842829, -8.617407410654438, -5.602626942129754, -4.408132881758361, -3.795885919286081, -3.779905247297731,
+		-4.61287534770168, -3.6016570158914125, -6.660568191066935, -3.0304468951750705, -3.1696758384091366, -4.172044900808746, -5.972870615274554, -5.287953933851124,
+		-6.06436295569922, -5.874089070714771, -6.528396417958452, -7.78115938645382, -3.279129959385242, -4.471833658663547, -6.851623427829645, -3.4077111075548103,
+		-2.725000784483497, -7.742367755877834, -8.084117049599891, -9.770516003170119, -2.693524406942683, -8.489582157708055, -8.815004558142682, -7.062465802067909,
+		-1.543996019861691, -11.37995391560422, -9.300512373924384, -3.2332448634009014, -9.300512373924384, -7.285609353382119, -1.671022457530389, -9.770516003170119,
+		5e-324, -2.0256863748213942, -5.408692075813757, -6.815605724136383, -3.1657595007516566, -10.28134162693611, -9.770516003170119, -8.121857377582739,
+		-7.103287796588164, -6.467299029868168, -3.786579722482929, -3.879977374652098, -5.010052932775993, -4.4494591496525935, -4.968135647894323, -5.0939558210953555,
+		-5.342082995682082, -6.937302659113904, -4.659733760468925, -9.182729338268, -6.159598090525895, -4.951848642919624, -6.261960103187465, -4.634717566119858,
+		-5.0939558210953555, -3.9269715861387597, -7.914218012804493, -4.890748984278903, -5.7100729926237, -5.346867693805418, -6.066747936562432, -6.815605724136383,
+		-6.707125081142314, -6.617779980806464, -6.445479982473528, -9.300512373924384, -3.646489282979166, -3.5086427122808135, -7.595764281685959, -3.748037402532968,
+		-2.549934781708426, -7.737145991302556, -9.411122424874229, -7.737145991302556, -1.8838665055004444, 5e-324, -10.509734713542338, -5.4695406172045375,
+		-2.979791342940749, 5e-324, -9.593443981668182, -3.4552850554093975, -8.637932536640747, -6.103015466278085, -2.0072491509983745, -8.067387678173134,
+		-8.494831693000073, -2.228390445937864, -5.116107167189976, -9.816587532982393, -3.208924346528718, -10.509734713542338, -6.261239471492979, -8.494831693000073,
+		-6.748534597848775, -10.104269605434173, -2.522719874407906, -5.982526069023958, -3.36375024582795, -4.550018864748936, -3.94587918701021, -2.9895001570677104,
+		-3.728677087606158, -5.879871914963875, -4.920615147206276, -7.3110615959916565, -7.870677383927079, -5.870163100836914, -5.0459029085167275, -6.374568156799982,
+		-4.157105317222771, -4.727449177398193, -8.258442914935843, -4.857245533273687, -4.009947672886484, -3.688627241285873, -5.589753787714213, -6.833434041635262,
+		-7.075747509057192, -8.369668550046066, -7.565295734375897, -11.202881894102283, -3.65643562035626, -4.336990819218845, -8.067387678173134, -3.796474792285864,
+		-2.006594075794351, -6.858906679370448, -9.080522709830827, -10.5846001066071, -1.5425600500626184, -11.277747287167045, -6.177880859342848, -5.893252224377957,
+		-2.2915509668386567, -10.5846001066071, 5e-324, -9.891452926047155, -7.015067410125731, -6.921038460477455, -1.4334260453001337, -7.183402724944945,
+		5e-324, -7.981910421162717, -5.278810725220363, -9.485987817938991, -3.01906579090281, 5e-324, -8.975162194173, -10.179134998498936,
+		-4.540780329165191, -6.07374060009025, -4.351170253944321, -5.061141186082181, -3.9545765692235766, -4.844807194427867, -4.216412920256609, -5.431308512109322,
+		-5.675628466287345, -5.6147868070311, -3.5143008984396835, -8.44453394311083, -6.6143081930549785, -5.12076830158149, -4.733835441602254, -5.875069905294766,
+		-5.087431881313899, -4.730961876406522, -7.493557653248785, -4.659008303649826, -4.58814801798808, -4.800774924277363, -5.448801669556839, -6.858906679370448,
+		-6.755958710118006, -7.8437600826819, -6.723870395566506, -8.505158564927266, -3.6049893905245356, -4.549118674082345, -7.058239581990939, -3.7234124634412984,
+		-5.811476522817934, -5.847194605420013, -6.518362879261183, -5.525257508417913, -7.974214924082983, -3.2366792791442385, -5.2080024189824785, -8.755915501984374,
+		-11.358605187428758, -10.953140079320594, -8.586016465188976, -5.535559291945739, -4.346940445056108, -1.316465003227319, -5.827193938713343, -8.586016465188976,
+		-11.358605187428758, -3.950074620534131, -3.2928686879716773, -2.230994463051571, -9.972310826308867, -6.704644837271234, -8.555244806522223, -7.817645863391443,
+		-10.442314455554602, -7.446582182000611, -4.428110421477131, -5.451881868775866, -4.526112392947168, -4.469523173712242, -6.05281580604202, -4.875497835971559,
+		-5.365891420920343, -6.39576055716885, -3.2053993246743753, -9.161380610092538, -7.789072490947388, -4.046385300672039, -4.873969951793505, -4.364213601787074,
+		-4.463428690653481, -4.463428690653481, -6.28343137219493, -4.7940446908286605, -4.490630778458465, -4.6204526928328, -6.39576055716885, -4.177773988384201,
+		-9.854527790652483, -6.864366562147948, -9.972310826308867, -7.957407805766602, -2.0634412466375447, -5.01384640454152, -3.4926497734952555, -2.1354966761748964,
+		-1.3687182356288505, -9.83377567262456, 5e-324, -8.329698275848285, -1.8883973766007423, -10.526922853184505, 5e-324, -8.917484940750404,
+		-3.544060101715562, 5e-324, 5e-324, -10.526922853184505, -9.83377567262456, 5e-324, -1.2781312948340693, -8.73516338395645,
+		5e-324, -4.340714229284011, -7.435880399826189, 5e-324, -1.6362369329033755, 5e-324, -9.83377567262456, 5e-324,
+		-9.140628492064614, 5e-324, -5.119751081724386, -6.5015711624493555, -5.259064694121177, -7.0304152917180245, -5.682735766725913, -5.128760151666752,
+		-8.129027580386134, -7.061186950384778, -6.655721842276614, -8.329698275848285, -7.3914286372553555, -7.268826315163023, -6.383788126792972, -5.189384773483187,
+		-6.132473698512066, -6.789253234901136, -7.268826315163023, -6.072575556930997, -6.635102555073878, -7.0304152917180245, -7.092935648699359, -8.329698275848285,
+		-7.531190579630514, -9.83377567262456, -8.329698275848285, -8.917484940750404, -4.153603063607492, -6.383788126792972, -7.0304152917180245, -4.223388545567912,
+		-2.062117273074804, -6.006488112368469, -5.77515897646782, -7.8990522807184895, -1.8951652136119508, -6.9296517235303865, -5.866130754673547, -3.6728823257169667,
+		-1.9114588530980512, -9.198335264848751, 5e-324, -3.8496416909756475, -5.9402387268272685, -3.688946928220773, -2.233884642190068, -6.9296517235303865,
+		5e-324, -3.41041591098635, -4.837362039372701, -10.29694755351686, -3.1168776792140642, -5.6818270366756005, -5.468633816214559, -7.657890223901601,
+		-4.665735771695495, 5e-324, -4.456305896143462, -5.624118719054954, -4.029747004975498, -6.365121920792535, -5.115164003224775, -6.770587028900699,
+		-5.743070661916319, -6.107292811490435, -4.803886110176312, -7.463734209460644, -5.034257364611975, -4.854529842995067, -6.4257465426089695, -6.384924548088714,
+		-5.161149116466598, -5.178953741100106, -4.60997219717704, -4.694828732637159, -3.6453756799271333, -5.711980074846288, -5.6818270366756005, -7.001110687512531,
+		-7.731998196055324, -5.732599362049024, -5.711980074846288, -10.29694755351686, -2.823878465484663, -3.8834885963495034, -6.186073689343549, -2.943225223117229,
+		-1.6180840429210293, 5e-324, -5.724669018900403, -9.199293869117376, -1.8769721677807287, -9.353444548944633, -11.14520401817269, -7.534286105528464,
+		-2.070110795604532, -10.046591729504579, 5e-324, -5.980418044249175, -7.812999507997485, -9.535766105738588, -1.9702835914277665, -8.660297368384688,
+		-11.14520401817269, -8.94797944083647, -6.217950333015485, -6.530083501331429, -3.6530009755539474, -9.758909657052799, -10.452056837612744, -10.452056837612744,
+		-4.575722597758393, 5e-324, -2.871867419668203, -5.229001955565254, -4.200152954446855, -4.3607469555350455, -4.33285892399521, -6.027210205755933,
+		-5.793345884696622, -4.9651873645201166, -4.046002274619597, -8.43715381707048, -6.500813119031316, -4.813702168278998, -4.872327011626521, -5.648035792879487,
+		-4.58700621536042, -4.621641712023177, -7.777908188186215, -5.28727086368923, -3.431419401573934, -4.627532745260414, -5.405411105993455, -5.914095401318102,
+		-6.656567648440549, -6.925696312996582, -5.729103615968269, -8.50614668855743, -3.4775779265876983, -3.1866271143587905, -6.270006694971538, -3.592441933958541,
+		-1.4228876470217082, -6.292692246011829, -3.570500091152865, -7.754494159639865, -2.28230587525783, -9.047262462748932, -5.748783587281765, -9.28842451956582,
+		-2.1647517343612135, -8.42822325434271, -9.606878250684355, -7.836172190654133, -7.660968101629042, -8.130971730874778, -1.8883594360429885, -9.894560323136137,
+		5e-324, -4.301709409187217, -5.396604221455194, -7.101352313693619, -3.0326744778124537, -11.686319792364191, -10.076881879930092, -8.220583889564464,
+		-3.8771843942436535, -8.641797354640769, -3.5515590099455454, -4.882814534755854, -4.5317044354505285, -4.539547612911554, -4.310437644149179, -5.73048242289936,
+		-4.989285544697707, -5.1029105702054265, -4.064634793639581, -6.874135436991774, -7.153720299210935, -5.372771746087096, -4.471815378213048, -6.5623558129609325,
+		-4.73454762796528, -3.8865664740769437, -10.587707503696082, -5.21397349786329, -4.398075391344067, -5.0742787575311, -6.850037885412713, -5.345960488636439,
+		-5.9725869868548225, -7.5591854073191, -6.8822987476309345, -8.595277339005875, -3.2575207517105493, -5.085089673635315, -6.363309813225783, -3.3908960967090196,
+		-2.3556008962995425, 5e-324, -8.362849311108786, -7.541868759038956, -1.575619461072038, -8.275837934119156, -6.54123687873105, -9.507981615411788,
+		-3.3522140170125305, -11.453891764467102, -8.888942407005565, -7.502648045885675, -9.844453852033002, 5e-324, -1.7496481479208783, -8.318397548537952,
+		-10.760744583907156, -7.428540073731953, -7.177225645451047, -7.562071466356476, -4.472886023745373, 5e-324, -10.760744583907156, -9.844453852033002,
+		-6.965255394734962, -9.662132295239047, -1.7663239181558645, -4.884410344052807, -4.160194043865665, -4.654835902408307, -4.358827387179971, -3.821006259071969,
+		-4.520468738736387, -5.604566984520243, -4.490701778596865, -5.948560228534739, -5.082279917235246, -5.704498778558849, -5.237285663382237, -4.660425631887093,
+		-4.004393759084253, -4.42480420031744, -7.927531239850941, -4.924472926204877, -3.9968596753447216, -3.977985795099705, -6.717693316072607, -6.6832071400014375,
+		-7.035051156670504, -8.888942407005565, -3.104407417476974, -8.509452785300661, -3.897725198766411, -4.146689449702364, -5.777137962198821, -4.02853387743995,
+		-5.070094895514422, -4.323016151284626, -3.4342057969931514, -5.357776967966203, -6.754434116121639, -2.8133918282923895, -6.171287830776023, -4.076461370256707,
+		-6.487805452867691, -8.19788689108148, -5.029685357176545, -3.5168455823212845, -5.2817072492294415, -1.8014475521291393, -6.841445493111269, -3.797644816516256,
+		5e-324, -2.710408664089246, -3.8359129149589863, -3.324037579767222, -3.048683888309961, -3.893821797877309, -5.482024150242475, -4.591749864037596,
+		-8.392042905522436, -6.171287830776023, -5.1095218154938795, -5.525768699205332, -4.139474338085441, -4.123344956155558, -4.796689509419323, -4.85939963772678,
+		-4.316929705228223, -4.812500115445966, -5.2817072492294415, -5.584362863471385, -4.366691214787287, -4.17253520034633, -3.771984107040594, -3.699385265411229,
+		-5.925154761237114, -3.2895666458558668, -9.085190086082381, -3.2625669342116614, -3.9654868715399774, -4.560300731355093, -5.070094895514422, -5.085679626531119,
+		-5.794658272307745, -4.6160032759661, -7.224437745367375, -8.833875657801476, -2.983590724156273, -4.446308842751979, -4.1006054691860685, -3.0821852422355334,
+		-1.9425424462476122, -7.620358508869544, -6.4078019608522165, -7.446695014818704, -2.6100681018261978, -5.415500306678253, -7.126924627815423, -3.5742870031607774,
+		-3.6253263776586886, 5e-324, -11.680801519415963, -3.3079406988896456, -8.545307303486814, -8.246814314930816, -2.313371932197005, -6.901678026304434,
+		5e-324, -1.8092691381213863, -4.338022330084117, -6.260266520143677, -3.576400211494351, -8.545307303486814, -8.502747689068018, -10.071363606981862,
+		-6.131725434520743, 5e-324, -3.4156658894785776, -4.167092271576258, -3.327304420670515, -4.42027892132611, -4.296812061437454, -5.9124805236221905,
+		-5.241451148315864, -5.38185227256002, -4.806603023962669, -8.246814314930816, -4.494657214893638, -4.527749884478483, -4.288769951901372, -5.214656795178343,
+		-4.719505473505796, -4.057648450939061, -9.282906246617593, -4.103167686813235, -4.2759139437998375, -4.484114948581613, -5.7946974879658075, -5.540916967189708,
+		-7.418121642374648, -8.043215359689578, -7.506414249520326, -10.582189230747854, -2.9686996557003, -3.6358544697982413, -6.4285280913693335, -3.0728539647312614,
+		-2.010858817731114, -7.454358194123649, 5e-324, 5e-324, -8.840652555243539, 5e-324, -8.840652555243539, 5e-324,
+		-2.9031163501611132, 5e-324, 5e-324, -8.147505374683593, 5e-324, 5e-324, -5.439455173581384, 5e-324,
+		5e-324, -8.840652555243539, -5.6217767303753385, -6.538067462249494, -0.6662314025970428, -8.147505374683593, -6.894742406188226, -7.7420402665754295,
+		5e-324, 5e-324, -3.965455232042388, -6.761211013563703, -6.068063833003758, -6.6434279779073195, -6.068063833003758, -7.454358194123649,
+		-6.355745905455539, -6.761211013563703, -4.087062364137175, -6.538067462249494, -6.275703197782002, -4.833319370011068, -7.048893086015484, -7.048893086015484,
+		-4.729778691070228, -3.389614101677839, -6.355745905455539, -4.398001298753223, -5.439455173581384, -3.4069305516892996, -3.527446576201752, -5.950280797347375,
+		-8.147505374683593, -5.896213576077099, 5e-324, 5e-324, -3.0786011724633626, -4.851668508679265, -6.894742406188226, -3.1724972582304893,
+		-2.5122251727496274, -6.791872306221305, -8.420178702959788, -7.827114980956826, -1.367622439094898, -7.881182202227102, -10.07840677956332, -4.923671141493308,
+		-2.821227783356644, -10.771553960123265, 5e-324, -6.1514951616414235, -9.385259599003376, -8.46896886712922, -2.187450063424402, -7.17424169953482,
+		5e-324, -8.420178702959788, -4.819610171177567, -10.07840677956332, -2.909634296838892, -6.711110949576846, -6.910824249082671, -9.385259599003376,
+		-5.8017406605472654, -8.168864274678882, -3.2272218520695777, -5.145733026936777, -4.238492130582539, -4.900845614151084, -3.8598066598715914, -4.618821265419162,
+		-5.396275552439101, -5.529806945063624, -3.960309581521973, -7.6360597441941165, -5.079506741685486, -5.1712818618367296, -5.342208331168825, -2.5196292463219097,
+		-4.426795177236029, -3.9870968974856225, -7.404258130136792, -5.629890403620606, -4.03874748360567, -4.038152068285907, -5.731359863785466, -5.687048817460555,
+		-6.364834712859013, -6.574352012461458, -6.127163060981894, -10.366088852015102, -3.037432656794961, -4.19857236112676, -6.791872306221305, -3.1734052887778046,
+		-2.6381410468575135, -8.832097357548834, -3.2878578527972473, -9.342922981314825, -2.0827752485121502, -8.387411536287388, -8.792876644395552, -2.993550916865564,
+		-2.954137511237672, -9.411915852801775, -5.404582667569304, -3.8555015556761014, -4.431248968634364, -5.932875984375686, -2.478840980122657, -3.27372608566649,
+		-5.741054904190517, -6.340546165042165, -6.595652067059333, -1.8928434115073254, -2.7941309767894316, -7.733485068880723, -5.080243104273508, -8.313303564133665,
+		-4.386626550318417, -7.608321925926718, -3.438651645909219, -5.935081056934, -3.801136696991332, -4.6196735072611315, -4.437154497608406, -5.531825894476638,
+		-6.401998944255828, -5.25526740724352, -4.900271724824507, -6.288921799636857, -5.854529054622513, -5.611622811316936, -4.566042899127372, -5.274466190044851,
+		-5.220098947770855, -4.404619459971034, -7.861318440390608, -5.686222425565123, -4.422942055486699, -3.745983602276672, -5.298702806275292, -5.536260491544504,
+		-6.370800573399967, -8.959930729058717, -7.0881285521571264, -8.159152884306407, -3.374300676455474, -4.837941522582165, -6.363997826077214, -3.507040693811175,
+		-3.261047699079109, -8.911367344946495, -7.746379987333513, -8.911367344946495, -3.2137804941802566, -11.744580689002712, -8.882379808073242, -0.5726325935424873,
+		-3.1756493021820593, -10.828289957128556, -11.339115580894546, -8.21822016438655, -7.793336970421284, -7.427092575466401, -2.866989492847617, -7.496085446953352,
+		-12.437727869562657, -3.466025469859332, -5.833784044962184, -10.039832596764287, -4.225973472187459, -12.437727869562657, -4.75616750700312, -8.609086473073562,
+		-5.704325977725297, -9.798670539947398, -4.261055097614201, -5.831077683364441, -4.4749639393945415, -6.86177876641634, -4.883393045836909, -6.366990141560167,
+		-4.514017535593419, -5.50723310361103, -4.502498329745749, -9.547356111666492, -6.961264317631146, -6.223119771140465, -5.40245927028156, -5.133884644284952,
+		-4.889171890392783, -4.659516395050164, -10.491817720507344, -4.957299563488449, -5.3841421423689795, -4.801941008167073, -6.7439957307599565, -4.667504665403871,
+		-7.774288775450589, -6.203317143844285, -6.697934957383422, -8.724155802858348, -3.516604080130206, -4.793765920560127, -7.570193419107074, -3.6109198956950888,
+		-7.751061667550202, -5.783411531858483, -8.370100875956425, -6.789650500395577, -7.607960823909528, -7.918115752213368, -5.511989980278482, -4.0994049239268735,
+		-7.482797680955523, -8.236569483331902, -6.157127941652067, -4.455224801545873, -3.6787529937272816, -0.9388012008005227, 5e-324, -3.2719781277370537,
+		-10.316011025011738, -4.159032039426183, -2.8930397739623177, -3.9738896062905873, -10.316011025011738, -9.622863844451793, -10.316011025011738, -8.11878644767552,
+		-8.370100875956425, -6.327026978447464, -4.405214380971211, -4.629035668671919, -4.043134018465571, -4.8521792199861284, -5.090264351298537, -4.936113671471279,
+		-5.403356139275687, -6.914813643349583, -4.782621536284219, -8.929716663891847, -5.1512250510882245, -4.660019214191886, -5.095655199933414, -3.6800644693250923,
+		-7.057914486990256, -4.429906993561582, -10.316011025011738, -3.6866477715742896, -1.8062453494242976, -3.7749810258218353, -6.4039880195835925, -4.569807834471585,
+		-7.482797680955523, -9.217398736343629, -8.370100875956425, -8.11878644767552, -3.745128062672154, -5.002805045969951, -6.760662963522325, -3.8428922314380456,
+		-2.093241271008735, -6.908235932680408, -9.06772018203378, -9.473185290141945, -2.1549773615406043, 5e-324, -8.086890929022054, -7.45828226959968,
+		-1.4327384088388353, -9.473185290141945, 5e-324, -5.822527048848206, -6.8704956046975605, -9.473185290141945, -3.106714842410507, -7.275960712805725,
+		-7.68142582091389, -7.075290017343574, -5.018837993888437, -7.768437197903519, -5.889666351685834, 5e-324, -7.9691078933656705, -10.16633247070189,
+		-5.306520066340218, 5e-324, -3.5186440971385604, -5.0072771714873605, -4.4169394847936365, -5.02466891419923, -3.2525951200422054, -6.528746310975504,
+		-6.452760403997582, -4.407430696824609, -3.352887871190994, -8.556894558267789, -8.374573001473834, -6.158999285469418, -6.428662852418522, -5.561162284713799,
+		-5.02466891419923, -4.656944134073912, -8.780038109582, -4.781837407912801, -4.908837098674108, -5.330050563750412, -7.9691078933656705, -6.088795026796171,
+		-6.834127960526686, -6.765135089039735, -10.16633247070189, -10.16633247070189, -2.229136361912942, -2.904405377999139, -6.177348424137615, -2.343887741212572,
+		-1.7376213608330473, 5e-324, -11.239810114130426, -11.239810114130426, -1.3059388569598587, 5e-324, -7.0057036095331675, -1.460865896668899,
+		-2.096678491847694, 5e-324, 5e-324, -7.7433025526639465, -9.293899965075113, -5.37334205719713, -2.4664255173537795, -10.546662933570481,
+		5e-324, -4.456484913526467, -7.981713576108945, -7.628892201486202, -6.215929593284151, 5e-324, 5e-324, 5e-324,
+		-5.566486846958934, 5e-324, -5.089207345684147, -6.740000443800161, -6.334535335691998, -6.785462817876919, -6.305336180999735, -8.531759913028216,
+		-5.946505289405934, -6.263076371709852, -4.946390835283945, -8.14876766077211, -7.526238047426119, -8.349438356234263, -6.364612790929275, -7.455620480212166,
+		-5.892702583412958, -3.5078793921819402, 5e-324, -6.654842635459855, -5.260924349229305, -4.847893000737825, -7.433147624360107, -8.295371134963986,
+		-6.41952854852539, -9.630372201696327, -7.5509306600164905, -10.546662933570481, -5.222434185237944, -6.963143995114371, -8.061756283782481, -5.312884088160016,
+		-4.472078257224739, -4.6034142592858265, -5.813252183064161, 5e-324, -3.089637465420839, -7.547853238452267, -7.547853238452267, -4.342400433916207,
+		-3.6095129247067472, 5e-324, 5e-324, -6.854706057892321, -5.813252183064161, -6.854706057892321, -6.343880434126331, -5.938415326018166,
+		-6.343880434126331, 5e-324, -7.953318346560431, -6.343880434126331, -5.555423073762061, -8.646465527120377, -7.547853238452267, 5e-324,
+		-4.75464522900975, -8.646465527120377, -4.472078257224739, -4.356006085971986, -2.9066726149411424, -3.5712917118865497, -5.510971311191227, -6.854706057892321,
+		-7.037027614686276, -5.388368989098895, -2.5044281215330204, -7.037027614686276, -4.75464522900975, -4.5521209648982754, -4.1138660339671205, -4.69522180853895,
+		-4.568928083214657, -4.397970285071017, -8.646465527120377, -3.690638469519116, -5.149957965653896, -4.69522180853895, -7.260171166000486, -3.10913126010184,
+		-6.700555378065063, -2.969711724852095, -4.75464522900975, -8.646465527120377, -1.7915837377453077, -2.01051897143373, -5.873876804880595, -1.9341170444808495,
+		-3.1281693175974774, -6.622892757270456, -6.217427649162292, -10.149253281886617, -1.959176232167568, -9.050640993218508, -9.456106101326672, -10.149253281886617,
+		-4.302814506828893, -9.050640993218508, 5e-324, -7.846668188892572, -7.153521008332627, -8.539815369452517, -0.6984796900592644, -8.203343132831304,
+		-10.149253281886617, -9.050640993218508, -7.316039937830402, -7.751358009088247, -3.7556625279359857, -7.153521008332627, -9.456106101326672, 5e-324,
+		-10.149253281886617, -10.149253281886617, -5.671916467408411, -6.278052270978726, -5.779805429419596, -6.460373827772681, -6.257432983775991, -6.123901591151468,
+		-7.1047308441631944, -5.858793840738226, -7.584303924425081, -8.539815369452517, -6.278052270978726, -5.3370689265142, -5.3370689265142, -5.915146777289358,
+		-4.855948457162125, -4.125805688925585, -7.510195952271359, -5.845188188682448, -4.728718282614332, -2.1641093506879976, -5.742534034622365, -8.539815369452517,
+		-6.891156743865135, -8.357493812658563, -7.376664559646836, -7.664346632098617, -3.281278872916325, -4.445470807230416, -6.853416415882289, -3.393484359902362,
+		-2.236189776614869, -5.882509616310009, -7.674269085538064, -5.728358936482751, -1.7594156408019224, -7.674269085538064, 5e-324, -3.3048212330710425,
+		-2.2019984118665894, 5e-324, 5e-324, -7.2688039774299, -5.802466908636473, -3.579924523315963, -2.5009482091647124, -6.757978353663909,
+		5e-324, -6.421506117042696, -5.23192205016886, -6.981121904978119, -2.878478539941323, -7.2688039774299, -5.728358936482751, -7.674269085538064,
+		-4.3243649982634595, -8.36741626609801, -3.0298781863966915, -6.981121904978119, -6.981121904978119, -4.34206457536286, -5.07157940009368, -5.3716839925440185,
+		5e-324, 5e-324, -3.2860119011135462, -7.674269085538064, -8.36741626609801, -4.606216150404447, -5.882509616310009, -6.064831173103964,
+		-5.07157940009368, -6.981121904978119, -5.802466908636473, -7.2688039774299, -6.287974724418174, -4.162723646707043, 5e-324, -6.287974724418174,
+		-5.802466908636473, -7.674269085538064, -7.674269085538064, -4.812068204608596, -2.375951718990027, -3.7824487874274375, -6.757978353663909, -2.482702088936908,
+		-2.2336173357168265, -3.324180239297391, -3.146921399344323, -3.545290785353004, -3.80187990961856, -3.412503421928748, -4.1329966324182354, -3.4870419914920765,
+		-2.6652429753824727, -5.616102475988606, -4.960530392432781, -3.9015797608336693, -3.465239686661061, -4.060991588339184, -2.7665196684341287, -3.308065961540592,
+		-6.4016147309491895, -3.641050788645154, -2.898557021587795, -1.9796227118014293, -4.0210746740356385, -5.112293566357301, -2.9987466875363373, -9.630678137154261,
+		-4.184382227203013, -8.598106344275617, -4.578039846848457, -5.056191786892084, -4.727762307654355, -5.396334850892266, -5.654831735510474, -5.396540058428839,
+		-5.586213657465617, -5.367630105483844, -4.6505020505427135, -6.014386945781425, -6.402218752022002, -5.590670137724572, -5.015773351426579, -5.45159268689107,
+		-5.895527510010055, -5.117451256240031, -7.901280909641181, -5.396840052904875, -4.666881753121139, -4.219435800456912, -6.1337745360786435, -6.764129423288689,
+		-5.297296660823794, -8.655173492258061, -6.608607334898594, -8.408570120784113, -8.900113443560603, -6.921539204316853, -7.483206423980994, -12.309740801383217,
+		-2.8798128436707606, -3.3827488582130396, -3.0734662529310057, -3.548886412533814, -3.820599304344261, -3.643241676082363, -4.483469330741014, -2.0021233835022065,
+		-3.578505418131845, -6.224868963443671, -5.4331331944834895, -3.5491481926400215, -3.5285476066388384, -4.68428813595276, -3.6571448688970167, -3.3876377568395037,
+		-6.295764785076709, -3.5161852494916386, -2.9524703921006554, -2.7962315026936913, -4.666524245346428, -5.290491830490208, -3.844407883970436, -9.354675960252232,
+		-4.725874169730862, -8.347035449789848, -4.458459800859339, -5.070190252519546, -4.438829242888403, -5.335554819267718, -5.660376438668956, -5.6636249526247875,
+		-5.155188297309567, -5.095677068962343, -5.042418896133474, -6.030265721803846, -6.691077521720855, -5.717089800525845, -4.905905357792322, -5.291238935493207,
+		-6.246003275517645, -4.5111738053272665, -7.325384202257867, -5.168320462393025, -4.867017107704479, -4.980287115026467, -6.079995294215214, -7.0178995025099065,
+		-6.057230083442201, -7.736579985075016, -7.217170617617634, -8.363035791136289, -3.81665723051019, -2.060032864532377, -7.60736825359501, -3.935295917797455,
+		-6.184334005209778, -7.781523295567679, -6.417207841166563, -3.8045270808838367, -6.94295911514923, -8.049118609452723, -8.208967310394618, -7.931335573796339,
+		-7.19373663066556, -10.32923084659471, -9.307579599062729, -3.726642954405373, -2.9426223230898545, -6.935468443420072, -7.891726435701293, -7.3334985730407185,
+		-10.447013882251094, -2.6457760107208097, -0.6690612084208116, -1.6182084915330377, -8.634635125820303, -2.978286592562393, -8.519122238698458, -11.140161062811039,
+		-9.81840522282872, -10.223870330936883, -6.961935016608236, -7.666643019569257, -7.390656986880668, -8.07210812767742, -8.594629791206604, -8.888869264204544,
+		-7.921285237942838, -7.891726435701293, -7.268960051903147, -9.887398094315671, -9.39096120800178, -8.537471377366655, -8.157007571463907, -7.882064524789556,
+		-8.049118609452723, -8.537471377366655, -11.140161062811039, -8.383320697539396, -6.950506320784613, -7.24325169519294, -9.000094899314767, -10.223870330936883,
+		-7.781523295567679, -11.833308243370984, -8.483404156096379, -11.140161062811039, -3.678736211071021, -5.749948431558746, -7.5776955335527605, -3.818559231692822,
+		-2.6454769151167272, -3.7427594813639686, -3.564392468264589, -3.959716829976107, -4.223765396344763, -3.819839203774555, -4.537239000472113, -3.9014811472340587,
+		-3.080434636253661, -6.028568513941762, -5.347059967078091, -4.309870679545868, -3.875450732520252, -4.484535619682844, -3.181250933489846, -3.729843111415206,
+		-6.834748761666144, -4.083249745748535, -3.321328485354481, -2.381197920719294, -4.599836794635561, -5.521645986246932, -3.423364763151766, -10.015804002620413,
+		-4.792159951986379, -8.998067176705193, -5.022506451616607, -5.492749518681561, -5.170961691155962, -5.836698095608376, -6.10939048567934, -5.839778870450865,
+		-6.034370898661176, -5.800040977104479, -5.073876223551397, -6.456531512889551, -6.844617948686291, -6.038063925535252, -5.45475620150964, -5.891502023606368,
+		-6.342472090588274, -5.571456724849445, -8.34439746106912, -5.843638955861493, -5.116284232397985, -4.666257716675831, -6.57294147477608, -7.199578162141932,
+		-5.750610521920023, -9.055437972408528, -7.048267974992929, -8.833824903494929, -12.412590012964499, -7.324767867637443, -7.902534903863324, -1.0642025876945533,
+	},
+}
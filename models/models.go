@@ -0,0 +1,34 @@
+// Package models provides the pretrained models from the gibberdet
+// repository's testdata directory, compiled in with gibberdet.WriteGoSource.
+//
+// Each model is built on the first call to its function, and is safe for
+// concurrent use. The returned models are shared, so use WithMetadata,
+// WithEncoding or Convert to get a modified copy rather than changing them.
+//
+// The recommended thresholds separate a small set of ordinary English words
+// and sentences from random keyboard strings, with a margin on either side.
+// They are a starting point; use Model.Test with samples from your own inputs
+// to choose a better one.
+//
+// ChineseTest has no recommended threshold. It is a tiny model used by the
+// gibberdet tests, in which each rune was only seen followed by one other, so
+// scores depend almost entirely on the length of the input. It is included
+// for testing rune alphabets, not for detecting gibberish.
+package models
+
+//go:generate go run ../tool.go gen -pkg models -name EnglishOANC ../testdata/oanc-en.gibber english_oanc.go
+//go:generate go run ../tool.go gen -pkg models -name EnglishGutenberg ../testdata/gutenberg-en.gibber english_gutenberg.go
+//go:generate go run ../tool.go gen -pkg models -name ChineseTest ../testdata/test-cn.gibber chinese.go
+
+const (
+	// EnglishOANCThreshold is the recommended threshold for EnglishOANC,
+	// which was trained on the Open American National Corpus with an
+	// alphabet of ASCII letters, space, hyphen, apostrophe and underscore.
+	// It is the best of the English models.
+	EnglishOANCThreshold = 0.03
+
+	// EnglishGutenbergThreshold is the recommended threshold for
+	// EnglishGutenberg, which was trained on Project Gutenberg books with an
+	// alphabet of most printable ASCII characters.
+	EnglishGutenbergThreshold = 0.02
+)
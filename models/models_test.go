@@ -0,0 +1,121 @@
+package models
+
+import (
+	"bytes"
+	"io/ioutil"
+	"sync"
+	"testing"
+
+	"github.com/shabbyrobe/gibberdet"
+)
+
+var (
+	good = []string{
+		"hello world", "the quick brown fox jumps over the lazy dog",
+		"this is a perfectly normal sentence", "gibberish detection", "information",
+		"international business machines", "please enter your password",
+		"customer support", "thank you for your order", "another day in paradise",
+		"john smith", "weather forecast for tomorrow", "reading a good book",
+		"united states of america", "shipping address",
+	}
+	bad = []string{
+		"Ff7DPHaTaip", "9W5L9L30QG", "aqwxGdRkdF6F0EoVQ", "zxcvbnmqwrt",
+		"kjhsdfkjhsdf", "qwpoeiruty", "xkcdqzvbn", "jjjjjjjjjj", "asdfghjkl",
+		"mnbvcxzlkj", "ZxQwVbNmPl", "pqzxjwvkgh", "hdjfkslaqp",
+	}
+)
+
+var testModels = []struct {
+	name   string
+	file   string
+	model  func() *gibberdet.Model
+	thresh float64
+}{
+	{"EnglishOANC", "oanc-en.gibber", EnglishOANC, EnglishOANCThreshold},
+	{"EnglishGutenberg", "gutenberg-en.gibber", EnglishGutenberg, EnglishGutenbergThreshold},
+	{"ChineseTest", "test-cn.gibber", ChineseTest, 0},
+}
+
+func TestModels(t *testing.T) {
+	for _, tc := range testModels {
+		bts, err := ioutil.ReadFile("../testdata/" + tc.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var want gibberdet.Model
+		if err := want.UnmarshalBinary(bts); err != nil {
+			t.Fatal(err)
+		}
+		wantBts, err := want.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m := tc.model()
+		if m != tc.model() {
+			t.Fatal(tc.name, "expected the same model")
+		}
+		gotBts, err := m.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(wantBts, gotBts) {
+			t.Fatal(tc.name, "does not match", tc.file)
+		}
+
+		if tc.thresh == 0 {
+			continue
+		}
+		for _, s := range good {
+			if m.GibberScore(s) < tc.thresh {
+				t.Fatal(tc.name, s, m.GibberScore(s))
+			}
+		}
+		for _, s := range bad {
+			if m.GibberScore(s) >= tc.thresh {
+				t.Fatal(tc.name, s, m.GibberScore(s))
+			}
+		}
+	}
+}
+
+// TestModelsGenerated checks that the generated files are up to date.
+func TestModelsGenerated(t *testing.T) {
+	for _, tc := range []struct{ name, file, src string }{
+		{"EnglishOANC", "oanc-en.gibber", "english_oanc.go"},
+		{"EnglishGutenberg", "gutenberg-en.gibber", "english_gutenberg.go"},
+		{"ChineseTest", "test-cn.gibber", "chinese.go"},
+	} {
+		m, err := gibberdet.Load("../testdata/" + tc.file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var want bytes.Buffer
+		if err := gibberdet.WriteGoSource(&want, m, "models", tc.name); err != nil {
+			t.Fatal(err)
+		}
+		got, err := ioutil.ReadFile(tc.src)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(want.Bytes(), got) {
+			t.Fatal(tc.src, "is out of date; run 'go generate'")
+		}
+	}
+}
+
+func TestModelsConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, tc := range testModels {
+				if tc.model().GibberScore("hello world") <= 0 {
+					t.Error(tc.name)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
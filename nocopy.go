@@ -0,0 +1,59 @@
+package gibberdet
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// NewModelFromBytes decodes a model written by MarshalBinary, like
+// UnmarshalBinary, but uses the transition table in data directly rather than
+// copying it where possible. data must not be modified while the model is in
+// use.
+//
+// The table, or each part of a sparse or quantized table, is only used in
+// place on little endian machines, and only if it is aligned in memory for its
+// type: 8 bytes for StorageDense and StorageSparse, 4 for StorageFloat32, 2
+// for StorageQuant16 and 1 for StorageQuant8. Otherwise it is copied. The
+// position of the table in data depends on the size of the header, metadata
+// and alphabet, so data itself being aligned is not enough.
+func NewModelFromBytes(data []byte) (*Model, error) {
+	m := &Model{}
+	if err := m.unmarshalBinary(data, true); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var nativeLittleEndian = func() bool {
+	v := uint16(1)
+	return *(*byte)(unsafe.Pointer(&v)) == 1
+}()
+
+// viewFloat64s returns b as a []float64 that shares its memory. b must not be
+// empty, and must be aligned for the type; see modelReader.view.
+func viewFloat64s(b []byte) (out []float64) {
+	setSliceHeader(unsafe.Pointer(&out), b, 8)
+	return out
+}
+
+func viewFloat32s(b []byte) (out []float32) {
+	setSliceHeader(unsafe.Pointer(&out), b, 4)
+	return out
+}
+
+func viewUint32s(b []byte) (out []uint32) {
+	setSliceHeader(unsafe.Pointer(&out), b, 4)
+	return out
+}
+
+func viewUint16s(b []byte) (out []uint16) {
+	setSliceHeader(unsafe.Pointer(&out), b, 2)
+	return out
+}
+
+func setSliceHeader(slice unsafe.Pointer, b []byte, size int) {
+	hdr := (*reflect.SliceHeader)(slice)
+	hdr.Data = uintptr(unsafe.Pointer(&b[0]))
+	hdr.Len = len(b) / size
+	hdr.Cap = len(b) / size
+}
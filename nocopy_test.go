@@ -0,0 +1,163 @@
+package gibberdet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+	"unsafe"
+)
+
+// shiftedCopies returns 8 copies of data, each starting at a different offset
+// from an 8-byte aligned address.
+func shiftedCopies(data []byte) [][]byte {
+	out := make([][]byte, 8)
+	for i := range out {
+		raw := make([]byte, len(data)+16)
+		base := int((8 - uintptr(unsafe.Pointer(&raw[0]))%8) % 8)
+		out[i] = raw[base+i : base+i+len(data)]
+		copy(out[i], data)
+	}
+	return out
+}
+
+// inside returns true if p points into data.
+func inside(p unsafe.Pointer, data []byte) bool {
+	start := uintptr(unsafe.Pointer(&data[0]))
+	return uintptr(p) >= start && uintptr(p) < start+uintptr(len(data))
+}
+
+func tablePointer(table gramTable) unsafe.Pointer {
+	switch table := table.(type) {
+	case *denseTable:
+		return unsafe.Pointer(&table.gram[0])
+	case *sparseTable:
+		return unsafe.Pointer(&table.vals[0])
+	case *float32Table:
+		return unsafe.Pointer(&table.gram[0])
+	case *quant16Table:
+		return unsafe.Pointer(&table.gram[0])
+	case *quant8Table:
+		return unsafe.Pointer(&table.gram[0])
+	}
+	panic(table)
+}
+
+func TestNewModelFromBytes(t *testing.T) {
+	m := loadTestModel(t, "test-cn.gibber")
+	for _, cm := range convertTestModel(t, m) {
+		s := cm.Storage()
+		for _, enc := range [][]byte{marshalV1(t, cm), mustMarshal(t, cm)} {
+			var shared int
+			for _, data := range shiftedCopies(enc) {
+				out, err := NewModelFromBytes(data)
+				if err != nil {
+					t.Fatal(s, err)
+				}
+				if !bytes.Equal(mustMarshal(t, out), mustMarshal(t, cm)) {
+					t.Fatal(s, "does not match")
+				}
+				if inside(tablePointer(out.table), data) {
+					shared++
+				}
+			}
+
+			want := map[Storage]int{StorageDense: 1, StorageSparse: 1, StorageFloat32: 2, StorageQuant16: 4, StorageQuant8: 8}[s]
+			if !nativeLittleEndian {
+				want = 0
+			}
+			if shared != want {
+				t.Fatal(s, "shared", shared, "expected", want)
+			}
+		}
+	}
+}
+
+func TestNewModelFromBytesErrors(t *testing.T) {
+	m := loadTestModel(t, "test-cn.gibber")
+	enc := mustMarshal(t, m)
+
+	for _, i := range []int{0, len(formatMagic), len(enc) / 2, len(enc) - 1} {
+		var out Model
+		want := out.UnmarshalBinary(enc[:i])
+		if _, err := NewModelFromBytes(enc[:i]); err == nil || err.Error() != want.Error() {
+			t.Fatal(i, err, want)
+		}
+	}
+
+	// Values are still checked when the table is used in place:
+	v1 := marshalV1(t, m)
+	alphaSz := int(binary.LittleEndian.Uint32(v1[len(formatMagic)+4:]))
+	gramPos := len(formatMagic) + 4 + 4 + alphaSz + 4
+	for _, data := range shiftedCopies(v1) {
+		binary.LittleEndian.PutUint64(data[gramPos:], math.Float64bits(math.NaN()))
+		if _, err := NewModelFromBytes(data); err == nil {
+			t.Fatal()
+		}
+	}
+	if _, err := NewModelFromBytes(append(enc, 0)); errors.Is(err, ErrTruncated) || err == nil {
+		t.Fatal(err)
+	}
+}
+
+func mustMarshal(t testing.TB, m *Model) []byte {
+	t.Helper()
+	bts, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bts
+}
+
+// benchModelBytes returns the encoding of a dense model with a 1024 rune
+// alphabet (an 8MB table), shifted so the table is aligned.
+func benchModelBytes(b *testing.B) []byte {
+	runes := make([]rune, 1024)
+	for i := range runes {
+		runes[i] = rune(0x4E00 + i)
+	}
+	gram := make([]float64, len(runes)*len(runes))
+	for i := range gram {
+		gram[i] = -float64(i%97) / 10
+	}
+	m, err := NewModelFromData(&ModelData{Alphabet: string(runes), Storage: StorageDense, Gram: gram})
+	if err != nil {
+		b.Fatal(err)
+	}
+	enc := mustMarshal(b, m)
+	for _, data := range shiftedCopies(enc) {
+		out, err := NewModelFromBytes(data)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if inside(tablePointer(out.table), data) {
+			return data
+		}
+	}
+	b.Skip("table can't be used in place")
+	return nil
+}
+
+func BenchmarkUnmarshalBinary(b *testing.B) {
+	data := benchModelBytes(b)
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var m Model
+		if err := m.UnmarshalBinary(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNewModelFromBytes(b *testing.B) {
+	data := benchModelBytes(b)
+	b.SetBytes(int64(len(data)))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := NewModelFromBytes(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,351 @@
+package gibberdet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// pyList is a Python list decoded by unpickle. It is a pointer so that lists
+// in the memo are shared with the stack, as in Python.
+type pyList struct {
+	items []interface{}
+}
+
+// unpickle decodes the subset of Python's pickle format needed for a dict of
+// lists of numbers, in protocols 0 to 4. Values are decoded as
+// map[string]interface{}, *pyList, float64, int64, string, bool and nil.
+func unpickle(data []byte) (interface{}, error) {
+	var (
+		stack []interface{}
+		marks []int
+		memo  = map[int64]interface{}{}
+		pos   int
+	)
+
+	errTruncated := fmt.Errorf("%w: pickle", ErrTruncated)
+	take := func(n int) ([]byte, error) {
+		if n < 0 || len(data)-pos < n {
+			return nil, errTruncated
+		}
+		b := data[pos : pos+n]
+		pos += n
+		return b, nil
+	}
+	line := func() (string, error) {
+		end := bytes.IndexByte(data[pos:], '\n')
+		if end < 0 {
+			return "", errTruncated
+		}
+		s := string(data[pos : pos+end])
+		pos += end + 1
+		return s, nil
+	}
+	pop := func() (interface{}, error) {
+		if len(stack) == 0 || (len(marks) > 0 && len(stack) <= marks[len(marks)-1]) {
+			return nil, fmt.Errorf("gibberdet: pickle stack underflow")
+		}
+		v := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		return v, nil
+	}
+	popMark := func() ([]interface{}, error) {
+		if len(marks) == 0 {
+			return nil, fmt.Errorf("gibberdet: pickle mark not found")
+		}
+		mark := marks[len(marks)-1]
+		marks = marks[:len(marks)-1]
+		items := append([]interface{}(nil), stack[mark:]...)
+		stack = stack[:mark]
+		return items, nil
+	}
+	top := func() (interface{}, error) {
+		if len(stack) == 0 {
+			return nil, fmt.Errorf("gibberdet: pickle stack underflow")
+		}
+		return stack[len(stack)-1], nil
+	}
+	setItems := func(dict interface{}, items []interface{}) error {
+		d, ok := dict.(map[string]interface{})
+		if !ok || len(items)%2 != 0 {
+			return fmt.Errorf("gibberdet: unsupported pickle SETITEM")
+		}
+		for i := 0; i < len(items); i += 2 {
+			k, ok := items[i].(string)
+			if !ok {
+				return fmt.Errorf("gibberdet: unsupported pickle dict key %T", items[i])
+			}
+			d[k] = items[i+1]
+		}
+		return nil
+	}
+	appendItems := func(list interface{}, items []interface{}) error {
+		l, ok := list.(*pyList)
+		if !ok {
+			return fmt.Errorf("gibberdet: unsupported pickle APPEND")
+		}
+		l.items = append(l.items, items...)
+		return nil
+	}
+
+	for {
+		op, err := take(1)
+		if err != nil {
+			return nil, err
+		}
+
+		switch op[0] {
+		case 0x80: // PROTO
+			if _, err := take(1); err != nil {
+				return nil, err
+			}
+		case 0x95: // FRAME
+			if _, err := take(8); err != nil {
+				return nil, err
+			}
+
+		case '.': // STOP
+			if len(stack) != 1 || len(marks) != 0 {
+				return nil, fmt.Errorf("gibberdet: pickle stack not empty at STOP")
+			}
+			return stack[0], nil
+
+		case '(': // MARK
+			marks = append(marks, len(stack))
+
+		case '}': // EMPTY_DICT
+			stack = append(stack, map[string]interface{}{})
+		case ']': // EMPTY_LIST
+			stack = append(stack, &pyList{})
+		case 'd': // DICT
+			items, err := popMark()
+			if err != nil {
+				return nil, err
+			}
+			dict := map[string]interface{}{}
+			if err := setItems(dict, items); err != nil {
+				return nil, err
+			}
+			stack = append(stack, dict)
+		case 'l': // LIST
+			items, err := popMark()
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, &pyList{items: items})
+
+		case 's', 'a': // SETITEM, APPEND
+			var items []interface{}
+			n := 1
+			if op[0] == 's' {
+				n = 2
+			}
+			for i := 0; i < n; i++ {
+				v, err := pop()
+				if err != nil {
+					return nil, err
+				}
+				items = append([]interface{}{v}, items...)
+			}
+			target, err := top()
+			if err != nil {
+				return nil, err
+			}
+			if op[0] == 's' {
+				err = setItems(target, items)
+			} else {
+				err = appendItems(target, items)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+		case 'u', 'e': // SETITEMS, APPENDS
+			items, err := popMark()
+			if err != nil {
+				return nil, err
+			}
+			target, err := top()
+			if err != nil {
+				return nil, err
+			}
+			if op[0] == 'u' {
+				err = setItems(target, items)
+			} else {
+				err = appendItems(target, items)
+			}
+			if err != nil {
+				return nil, err
+			}
+
+		case 'p', 'q', 'r', 0x94: // PUT, BINPUT, LONG_BINPUT, MEMOIZE
+			var idx int64
+			switch op[0] {
+			case 'p':
+				s, err := line()
+				if err != nil {
+					return nil, err
+				}
+				if idx, err = strconv.ParseInt(s, 10, 64); err != nil {
+					return nil, fmt.Errorf("gibberdet: invalid pickle PUT %q", s)
+				}
+			case 'q':
+				b, err := take(1)
+				if err != nil {
+					return nil, err
+				}
+				idx = int64(b[0])
+			case 'r':
+				b, err := take(4)
+				if err != nil {
+					return nil, err
+				}
+				idx = int64(binary.LittleEndian.Uint32(b))
+			default:
+				idx = int64(len(memo))
+			}
+			v, err := top()
+			if err != nil {
+				return nil, err
+			}
+			memo[idx] = v
+
+		case 'g', 'h', 'j': // GET, BINGET, LONG_BINGET
+			var idx int64
+			switch op[0] {
+			case 'g':
+				s, err := line()
+				if err != nil {
+					return nil, err
+				}
+				if idx, err = strconv.ParseInt(s, 10, 64); err != nil {
+					return nil, fmt.Errorf("gibberdet: invalid pickle GET %q", s)
+				}
+			case 'h':
+				b, err := take(1)
+				if err != nil {
+					return nil, err
+				}
+				idx = int64(b[0])
+			default:
+				b, err := take(4)
+				if err != nil {
+					return nil, err
+				}
+				idx = int64(binary.LittleEndian.Uint32(b))
+			}
+			v, ok := memo[idx]
+			if !ok {
+				return nil, fmt.Errorf("gibberdet: pickle memo %d not found", idx)
+			}
+			stack = append(stack, v)
+
+		case 'N': // NONE
+			stack = append(stack, nil)
+		case 0x88: // NEWTRUE
+			stack = append(stack, true)
+		case 0x89: // NEWFALSE
+			stack = append(stack, false)
+
+		case 'G': // BINFLOAT
+			b, err := take(8)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, math.Float64frombits(binary.BigEndian.Uint64(b)))
+		case 'F': // FLOAT
+			s, err := line()
+			if err != nil {
+				return nil, err
+			}
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, fmt.Errorf("gibberdet: invalid pickle FLOAT %q", s)
+			}
+			stack = append(stack, f)
+
+		case 'K': // BININT1
+			b, err := take(1)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, int64(b[0]))
+		case 'M': // BININT2
+			b, err := take(2)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, int64(binary.LittleEndian.Uint16(b)))
+		case 'J': // BININT
+			b, err := take(4)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, int64(int32(binary.LittleEndian.Uint32(b))))
+		case 'I', 'L': // INT, LONG
+			s, err := line()
+			if err != nil {
+				return nil, err
+			}
+			switch s {
+			case "00":
+				stack = append(stack, false)
+			case "01":
+				stack = append(stack, true)
+			default:
+				v, err := strconv.ParseInt(strings.TrimSuffix(s, "L"), 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("gibberdet: invalid pickle INT %q", s)
+				}
+				stack = append(stack, v)
+			}
+
+		case 'U', 'T', 'X', 0x8c: // SHORT_BINSTRING, BINSTRING, BINUNICODE, SHORT_BINUNICODE
+			var n int
+			if op[0] == 'U' || op[0] == 0x8c {
+				b, err := take(1)
+				if err != nil {
+					return nil, err
+				}
+				n = int(b[0])
+			} else {
+				b, err := take(4)
+				if err != nil {
+					return nil, err
+				}
+				if n = int(binary.LittleEndian.Uint32(b)); n < 0 {
+					return nil, errTruncated
+				}
+			}
+			b, err := take(n)
+			if err != nil {
+				return nil, err
+			}
+			stack = append(stack, string(b))
+		case 'S': // STRING
+			s, err := line()
+			if err != nil {
+				return nil, err
+			}
+			if len(s) < 2 || (s[0] != '\'' && s[0] != '"') || s[len(s)-1] != s[0] || strings.ContainsRune(s, '\\') {
+				return nil, fmt.Errorf("gibberdet: unsupported pickle STRING %q", s)
+			}
+			stack = append(stack, s[1:len(s)-1])
+		case 'V': // UNICODE
+			s, err := line()
+			if err != nil {
+				return nil, err
+			}
+			if strings.ContainsRune(s, '\\') {
+				return nil, fmt.Errorf("gibberdet: unsupported pickle UNICODE %q", s)
+			}
+			stack = append(stack, s)
+
+		default:
+			return nil, fmt.Errorf("gibberdet: unsupported pickle opcode 0x%02x at %d", op[0], pos-1)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+package gibberdet
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+)
+
+// PythonAlphabet matches the accepted_chars used by rrenaud's
+// Gibberish-Detector, which lowercases its input and keeps only the letters
+// a to z and space, in that order.
+var PythonAlphabet = NewClassAlphabet(append(FoldCase([]rune(alphaLower)), []rune{' '})...)
+
+// ReadPythonModel reads the gib_model.pki file written by rrenaud's
+// Gibberish-Detector: a pickled dict holding the matrix of transition log
+// probabilities as 'mat', and the threshold as 'thresh'. Pickle protocols 0 to
+// 4 are supported, but only the opcodes needed for that dict.
+//
+// The model uses PythonAlphabet, StorageDense and CompatPython, and the
+// threshold is also stored in its metadata as MetaThreshold.
+func ReadPythonModel(r io.Reader) (m *Model, thresh float64, err error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	v, err := unpickle(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	dict, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, 0, fmt.Errorf("gibberdet: expected a pickled dict, found %T", v)
+	}
+
+	thresh, ok = pyNumber(dict["thresh"])
+	if !ok {
+		return nil, 0, fmt.Errorf("gibberdet: expected a number for 'thresh'")
+	}
+	if err := checkFinite("thresh", thresh); err != nil {
+		return nil, 0, err
+	}
+
+	n := PythonAlphabet.Len()
+	rows, ok := dict["mat"].(*pyList)
+	if !ok || len(rows.items) != n {
+		return nil, 0, fmt.Errorf("gibberdet: expected %d rows in 'mat'", n)
+	}
+	gram := make([]float64, 0, n*n)
+	for i, row := range rows.items {
+		cols, ok := row.(*pyList)
+		if !ok || len(cols.items) != n {
+			return nil, 0, fmt.Errorf("gibberdet: expected %d columns in 'mat' row %d", n, i)
+		}
+		for _, col := range cols.items {
+			f, ok := pyNumber(col)
+			if !ok {
+				return nil, 0, fmt.Errorf("gibberdet: expected a number in 'mat' row %d, found %T", i, col)
+			}
+			if err := checkFinite("mat", f); err != nil {
+				return nil, 0, err
+			}
+			gram = append(gram, f)
+		}
+	}
+
+	meta := map[string]string{
+		MetaAlphabet:  AlphabetKind(PythonAlphabet),
+		MetaThreshold: strconv.FormatFloat(thresh, 'g', -1, 64),
+		MetaCompat:    CompatPython.String(),
+	}
+	m = &Model{}
+	if err := m.decoded(PythonAlphabet, &denseTable{gram: gram, n: n}, meta); err != nil {
+		return nil, 0, err
+	}
+	return m, thresh, nil
+}
+
+func pyNumber(v interface{}) (float64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return v, true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// pythonJSON is the layout written by WritePythonJSON. 'mat' and 'thresh'
+// are the same as the keys in gib_model.pki.
+type pythonJSON struct {
+	AcceptedChars string      `json:"accepted_chars"`
+	Mat           [][]float64 `json:"mat"`
+	Thresh        float64     `json:"thresh"`
+}
+
+// WritePythonJSON writes the model and threshold as JSON that can replace
+// gib_model.pki in rrenaud's Gibberish-Detector:
+//
+//	model_data = json.load(open('gib_model.json'))
+//	model_mat = model_data['mat']
+//	threshold = model_data['thresh']
+//
+// The first rune of each symbol in the alphabet is written as
+// 'accepted_chars'. The Python code lowercases its input and then only
+// accepts those runes, so models with other alphabets than PythonAlphabet
+// need its accepted_chars to be replaced. Alphabets with OTHER, and
+// ByteAlphabet, are not supported.
+func WritePythonJSON(w io.Writer, m *Model, thresh float64) error {
+	if _, ok := m.alpha.(*byteAlphabet); ok {
+		return fmt.Errorf("gibberdet: ByteAlphabet can't be written for Python")
+	}
+	if m.alpha.FindRune(OtherRune) >= 0 {
+		return fmt.Errorf("gibberdet: alphabets with OTHER can't be written for Python")
+	}
+
+	n := m.alpha.Len()
+	gram := expandTable(m.table, n)
+	out := pythonJSON{
+		AcceptedChars: string(m.alpha.Runes()),
+		Mat:           make([][]float64, n),
+		Thresh:        thresh,
+	}
+	for i := range out.Mat {
+		out.Mat[i] = gram[i*n : i*n+n]
+	}
+	return json.NewEncoder(w).Encode(&out)
+}
@@ -0,0 +1,142 @@
+package gibberdet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// The python-*.pki files in testdata were written by a Python 3 port of
+// gib_detect_train.py from rrenaud's Gibberish-Detector, trained on this
+// package's README.md and LICENSE, using pickle protocols 0, 2 and 4.
+// python.json holds the same dict, written with json.dump.
+
+func loadPythonJSON(t *testing.T) (out pythonJSON) {
+	t.Helper()
+	bts, err := ioutil.ReadFile("testdata/python.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(bts, &out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func loadPythonModel(t *testing.T, name string) (*Model, float64) {
+	t.Helper()
+	f, err := os.Open("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	m, thresh, err := ReadPythonModel(f)
+	if err != nil {
+		t.Fatal(name, err)
+	}
+	return m, thresh
+}
+
+func TestReadPythonModel(t *testing.T) {
+	want := loadPythonJSON(t)
+	for _, name := range []string{"python-p0.pki", "python-p2.pki", "python-p4.pki"} {
+		m, thresh := loadPythonModel(t, name)
+		if thresh != want.Thresh {
+			t.Fatal(name, thresh, want.Thresh)
+		}
+		if m.Metadata()[MetaThreshold] == "" || m.Storage() != StorageDense {
+			t.Fatal(name, m.Metadata(), m.Storage())
+		}
+		if string(m.Alphabet().Runes()) != "abcdefghijklmnopqrstuvwxyz " {
+			t.Fatal(name, string(m.Alphabet().Runes()))
+		}
+		n := m.Alphabet().Len()
+		for i := 0; i < n; i++ {
+			if !reflect.DeepEqual(m.table.(*denseTable).gram[i*n:i*n+n], want.Mat[i]) {
+				t.Fatal(name, "row", i)
+			}
+		}
+
+		if m.GibberScore("Hello World") != m.GibberScore("hello world") {
+			t.Fatal(name)
+		}
+		if m.GibberScore("hello world") < thresh || m.GibberScore("zxcvbnmqwrt") >= thresh {
+			t.Fatal(name, m.GibberScore("hello world"), m.GibberScore("zxcvbnmqwrt"), thresh)
+		}
+	}
+}
+
+func TestReadPythonModelErrors(t *testing.T) {
+	p2, err := ioutil.ReadFile("testdata/python-p2.pki")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < len(p2); i += 7 {
+		if _, _, err := ReadPythonModel(bytes.NewReader(p2[:i])); !errors.Is(err, ErrTruncated) {
+			t.Fatal(i, err)
+		}
+	}
+
+	for _, tc := range []struct {
+		pickle string
+		err    string
+	}{
+		{"\x80\x02}q\x00.", "'thresh'"},
+		{"\x80\x02}q\x00X\x06\x00\x00\x00threshq\x01G?\xf0\x00\x00\x00\x00\x00\x00s.", "27 rows"},
+		{"\x80\x02]q\x00.", "dict"},
+		{"\x80\x02c__builtin__\nset\n.", "opcode"},
+		{"\x80\x02}q\x00K\x01K\x02s.", "key"},
+		{"\x80\x02h\x05.", "memo"},
+		{"\x80\x02s.", "underflow"},
+		{"\x80\x02}}.", "not empty"},
+	} {
+		_, _, err := ReadPythonModel(strings.NewReader(tc.pickle))
+		if err == nil || !strings.Contains(err.Error(), tc.err) {
+			t.Fatalf("%q: %v", tc.pickle, err)
+		}
+	}
+}
+
+func TestWritePythonJSON(t *testing.T) {
+	m, thresh := loadPythonModel(t, "python-p2.pki")
+	var buf bytes.Buffer
+	if err := WritePythonJSON(&buf, m, thresh); err != nil {
+		t.Fatal(err)
+	}
+
+	var got pythonJSON
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := loadPythonJSON(t)
+	want.AcceptedChars = "abcdefghijklmnopqrstuvwxyz "
+	if !reflect.DeepEqual(got, want) {
+		t.Fatal("does not match")
+	}
+
+	// Other storage is expanded:
+	quant, err := m.Convert(StorageQuant8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WritePythonJSON(ioutil.Discard, quant, thresh); err != nil {
+		t.Fatal(err)
+	}
+
+	tr := NewTrainer(WithOther(ASCIIAlpha))
+	if err := tr.Add(strings.NewReader("hello")); err != nil {
+		t.Fatal(err)
+	}
+	other, err := tr.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := WritePythonJSON(ioutil.Discard, other, 0); err == nil {
+		t.Fatal()
+	}
+}
@@ -0,0 +1,204 @@
+package gibberdet
+
+import (
+	"fmt"
+	"math"
+)
+
+type float32Table struct {
+	gram []float32
+	n    int
+}
+
+func newFloat32Table(gram []float64, n int) *float32Table {
+	ft := &float32Table{gram: make([]float32, len(gram)), n: n}
+	for i, v := range gram {
+		ft.gram[i] = float32(v)
+	}
+	return ft
+}
+
+func (f *float32Table) logProb(from, to int) float64 {
+	return float64(f.gram[from*f.n+to])
+}
+
+func (f *float32Table) storage() Storage { return StorageFloat32 }
+
+func (f *float32Table) kind() uint32 { return tableKindFloat32 }
+
+func (f *float32Table) size() uint64 { return uint64(len(f.gram)) * 4 }
+
+func (f *float32Table) marshal(mw *modelWriter) {
+	for _, v := range f.gram {
+		mw.uint32(math.Float32bits(v))
+	}
+}
+
+func readFloat32Table(mr *modelReader, n int) *float32Table {
+	return &float32Table{gram: mr.float32s(uint64(n)*uint64(n), "float32 table"), n: n}
+}
+
+// quantRows holds the parameters used to reconstruct each row of a quantized
+// table: logProb = min + q*scale.
+type quantRows struct {
+	n     int
+	min   []float64
+	scale []float64
+}
+
+func newQuantRows(gram []float64, n int, levels float64) (qr quantRows) {
+	qr = quantRows{n: n, min: make([]float64, n), scale: make([]float64, n)}
+	for from := 0; from < n; from++ {
+		lo, hi := math.Inf(1), math.Inf(-1)
+		for _, v := range gram[from*n : from*n+n] {
+			lo, hi = math.Min(lo, v), math.Max(hi, v)
+		}
+		qr.min[from] = lo
+		if hi > lo {
+			qr.scale[from] = (hi - lo) / levels
+		}
+	}
+	return qr
+}
+
+func (qr *quantRows) quantize(from int, v float64) float64 {
+	if qr.scale[from] == 0 {
+		return 0
+	}
+	return math.Round((v - qr.min[from]) / qr.scale[from])
+}
+
+func (qr *quantRows) size() uint64 { return uint64(qr.n) * 16 }
+
+func (qr *quantRows) marshal(mw *modelWriter) {
+	for i := 0; i < qr.n; i++ {
+		mw.float64(qr.min[i])
+		mw.float64(qr.scale[i])
+	}
+}
+
+func readQuantRows(mr *modelReader, n int) (qr quantRows) {
+	vals := mr.float64s(uint64(n)*2, "quantized table")
+	if mr.err != nil {
+		return qr
+	}
+	qr = quantRows{n: n, min: make([]float64, n), scale: make([]float64, n)}
+	for i := 0; i < n; i++ {
+		qr.min[i], qr.scale[i] = vals[i*2], vals[i*2+1]
+	}
+	return qr
+}
+
+type quant16Table struct {
+	quantRows
+	gram []uint16
+}
+
+func newQuant16Table(gram []float64, n int) *quant16Table {
+	qt := &quant16Table{quantRows: newQuantRows(gram, n, math.MaxUint16), gram: make([]uint16, len(gram))}
+	for i, v := range gram {
+		qt.gram[i] = uint16(qt.quantize(i/n, v))
+	}
+	return qt
+}
+
+func (q *quant16Table) logProb(from, to int) float64 {
+	return q.min[from] + float64(q.gram[from*q.n+to])*q.scale[from]
+}
+
+func (q *quant16Table) storage() Storage { return StorageQuant16 }
+
+func (q *quant16Table) kind() uint32 { return tableKindQuant16 }
+
+func (q *quant16Table) size() uint64 { return q.quantRows.size() + uint64(len(q.gram))*2 }
+
+func (q *quant16Table) marshal(mw *modelWriter) {
+	q.quantRows.marshal(mw)
+	for _, v := range q.gram {
+		mw.uint16(v)
+	}
+}
+
+func readQuant16Table(mr *modelReader, n int) *quant16Table {
+	qr := readQuantRows(mr, n)
+	return &quant16Table{quantRows: qr, gram: mr.uint16s(uint64(n) * uint64(n))}
+}
+
+type quant8Table struct {
+	quantRows
+	gram []uint8
+}
+
+func newQuant8Table(gram []float64, n int) *quant8Table {
+	qt := &quant8Table{quantRows: newQuantRows(gram, n, math.MaxUint8), gram: make([]uint8, len(gram))}
+	for i, v := range gram {
+		qt.gram[i] = uint8(qt.quantize(i/n, v))
+	}
+	return qt
+}
+
+func (q *quant8Table) logProb(from, to int) float64 {
+	return q.min[from] + float64(q.gram[from*q.n+to])*q.scale[from]
+}
+
+func (q *quant8Table) storage() Storage { return StorageQuant8 }
+
+func (q *quant8Table) kind() uint32 { return tableKindQuant8 }
+
+func (q *quant8Table) size() uint64 { return q.quantRows.size() + uint64(len(q.gram)) }
+
+func (q *quant8Table) marshal(mw *modelWriter) {
+	q.quantRows.marshal(mw)
+	mw.bytes(q.gram)
+}
+
+func readQuant8Table(mr *modelReader, n int) *quant8Table {
+	qr := readQuantRows(mr, n)
+	return &quant8Table{quantRows: qr, gram: mr.bytes(uint64(n) * uint64(n))}
+}
+
+// AccuracyReport compares the scores from two models over a set of samples.
+type AccuracyReport struct {
+	Samples int
+
+	MaxAbsError  float64
+	MeanAbsError float64
+
+	// Largest error relative to the reference score. Samples with a
+	// reference score of 0 are not included.
+	MaxRelError float64
+
+	// The sample with the largest absolute error.
+	Worst string
+}
+
+func (r *AccuracyReport) String() string {
+	return fmt.Sprintf("samples: %d, max abs error: %g, mean abs error: %g, max rel error: %g, worst: %q",
+		r.Samples, r.MaxAbsError, r.MeanAbsError, r.MaxRelError, r.Worst)
+}
+
+// MeasureAccuracy scores each of the samples with both the ref model and m,
+// and reports the differences. It is intended to check the effect of
+// converting a model to one of the more compact Storage options.
+func MeasureAccuracy(ref, m *Model, samples []string) *AccuracyReport {
+	report := &AccuracyReport{Samples: len(samples)}
+	var total float64
+	for _, s := range samples {
+		want, got := ref.GibberScore(s), m.GibberScore(s)
+		diff := math.Abs(want - got)
+		total += diff
+		if diff > report.MaxAbsError {
+			report.MaxAbsError = diff
+			report.Worst = s
+		}
+		if want != 0 {
+			if rel := diff / math.Abs(want); rel > report.MaxRelError {
+				report.MaxRelError = rel
+			}
+		}
+	}
+	if len(samples) > 0 {
+		report.MeanAbsError = total / float64(len(samples))
+	}
+	return report
+}
@@ -0,0 +1,110 @@
+package gibberdet
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Number of out-of-alphabet runes reported in TrainerStats.TopSkipped.
+const statsTopSkipped = 20
+
+type trainerStats struct {
+	bytes        int64
+	runes        int64
+	invalid      int64
+	sequences    int64
+	skippedTotal int64
+	skipped      map[rune]int64
+	rows         []int64
+}
+
+// TrainerStats describes the input a Trainer has seen so far. Augmentations
+// do not contribute to the totals, except for the row observations in Rows.
+type TrainerStats struct {
+	// Total number of bytes read, including invalid UTF-8.
+	Bytes int64
+
+	// Total number of valid runes read, whether in the alphabet or not.
+	Runes int64
+
+	// Number of invalid UTF-8 sequences encountered.
+	Invalid int64
+
+	// Number of unbroken sequences of runes that were in the alphabet.
+	Sequences int64
+
+	// Number of runes skipped because they were outside the alphabet. This
+	// is always zero if the alphabet has an OTHER symbol (see WithOther).
+	Skipped int64
+
+	// The most frequently skipped runes, most common first.
+	TopSkipped []RuneCount
+
+	// Number of transitions observed from each rune in the alphabet, in
+	// alphabet order. This does not include the pair weight.
+	Rows []RowStats
+}
+
+type RowStats struct {
+	Rune         rune
+	Observations int64
+}
+
+// Stats returns the statistics for all of the input passed to Add so far.
+func (t *Trainer) Stats() *TrainerStats {
+	st := &TrainerStats{
+		Bytes:     t.stats.bytes,
+		Runes:     t.stats.runes,
+		Invalid:   t.stats.invalid,
+		Sequences: t.stats.sequences,
+		Skipped:   t.stats.skippedTotal,
+	}
+
+	for r, n := range t.stats.skipped {
+		st.TopSkipped = append(st.TopSkipped, RuneCount{Rune: r, Count: n})
+	}
+	sort.Slice(st.TopSkipped, func(i, j int) bool {
+		a, b := st.TopSkipped[i], st.TopSkipped[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		return a.Rune < b.Rune
+	})
+	if len(st.TopSkipped) > statsTopSkipped {
+		st.TopSkipped = st.TopSkipped[:statsTopSkipped]
+	}
+
+	runes := t.alpha.Runes()
+	st.Rows = make([]RowStats, len(t.stats.rows))
+	for i, n := range t.stats.rows {
+		st.Rows[i] = RowStats{Rune: runes[i], Observations: n}
+	}
+
+	return st
+}
+
+// Unreliable returns the rows with fewer than min observations. The
+// probabilities for these rows will be dominated by the pair weight, so
+// scores for strings containing these runes are unlikely to be meaningful.
+func (st *TrainerStats) Unreliable(min int64) (rows []RowStats) {
+	for _, row := range st.Rows {
+		if row.Observations < min {
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func (st *TrainerStats) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "bytes:     %d\n", st.Bytes)
+	fmt.Fprintf(&sb, "runes:     %d\n", st.Runes)
+	fmt.Fprintf(&sb, "invalid:   %d\n", st.Invalid)
+	fmt.Fprintf(&sb, "sequences: %d\n", st.Sequences)
+	fmt.Fprintf(&sb, "skipped:   %d\n", st.Skipped)
+	for _, rc := range st.TopSkipped {
+		fmt.Fprintf(&sb, "  %q\t%d\n", rc.Rune, rc.Count)
+	}
+	return sb.String()
+}
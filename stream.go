@@ -0,0 +1,551 @@
+package gibberdet
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"unsafe"
+)
+
+// Size of the buffers used by WriteTo and ReadFrom, and the largest chunk
+// that is decoded at once.
+const streamBufferSize = 32 * 1024
+
+// Save writes the model to the file at path, using WriteTo.
+func (m *Model) Save(path string) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+	_, err = m.WriteTo(f)
+	return err
+}
+
+// Load reads a model from the file at path, using ReadFrom.
+func Load(path string) (*Model, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	m := &Model{}
+	if _, err := m.ReadFrom(f); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WriteTo writes the same encoding as MarshalBinary to w, without building
+// it in memory first.
+func (m *Model) WriteTo(w io.Writer) (n int64, err error) {
+	bodySize, err := m.bodySize()
+	if err != nil {
+		return 0, err
+	}
+
+	cw := &countingWriter{w: w}
+	sum := sha256.New()
+	mw := newModelWriter(io.MultiWriter(cw, sum))
+
+	mw.string(formatMagic)
+	mw.uint32(formatMarker)
+	mw.uint32(FormatVersion)
+
+	keys := make([]string, 0, len(m.meta))
+	for k := range m.meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	mw.uint32(uint32(len(keys)))
+	for _, k := range keys {
+		mw.uint32(uint32(len(k)))
+		mw.string(k)
+		mw.uint32(uint32(len(m.meta[k])))
+		mw.string(m.meta[k])
+	}
+
+	mw.uint32(uint32(bodySize))
+	if err := m.writeBody(mw); err != nil {
+		return cw.n, err
+	}
+	if err := mw.w.Flush(); err != nil {
+		return cw.n, err
+	}
+
+	_, err = cw.Write(sum.Sum(nil))
+	return cw.n, err
+}
+
+// ReadFrom reads a model written by WriteTo or MarshalBinary from r, in a
+// single pass. Only the decoded model is kept in memory, not the encoding.
+// Like UnmarshalBinary, decoding is strict; r must not contain anything
+// after the model.
+//
+// ReadFrom only checks the checksum after it has read the whole model, so
+// unlike UnmarshalBinary, it returns ErrTruncated for truncated input, or an
+// error describing the problem for corrupted input, rather than ErrChecksum.
+func (m *Model) ReadFrom(r io.Reader) (n int64, err error) {
+	mr := newModelReader(r, true)
+	err = m.decode(mr, -1)
+	return mr.n, err
+}
+
+// bodySize returns the size of the body written by writeBody.
+func (m *Model) bodySize() (uint64, error) {
+	alpha, err := marshalAlphabet(m.alpha)
+	if err != nil {
+		return 0, err
+	}
+	sz := 4 + uint64(len(alpha)) + 4
+	switch table := m.table.(type) {
+	case *denseTable:
+		sz += uint64(len(table.gram)) * 8
+	case encodedTable:
+		sz += 4 + table.size()
+	default:
+		return 0, fmt.Errorf("gibberdet: unsupported table %T", m.table)
+	}
+	if sz > math.MaxUint32 {
+		return 0, fmt.Errorf("gibberdet: model is too large to encode")
+	}
+	return sz, nil
+}
+
+// writeBody writes the alphabet and the table, which are the same in all
+// versions of the format.
+func (m *Model) writeBody(mw *modelWriter) error {
+	alpha, err := marshalAlphabet(m.alpha)
+	if err != nil {
+		return err
+	}
+	mw.uint32(uint32(len(alpha)))
+	mw.bytes(alpha)
+
+	switch table := m.table.(type) {
+	case *denseTable:
+		mw.uint32(uint32(len(table.gram)))
+		for _, f := range table.gram {
+			mw.float64(f)
+		}
+
+	case encodedTable:
+		mw.uint32(tableMarker)
+		mw.uint32(table.kind())
+		table.marshal(mw)
+
+	default:
+		return fmt.Errorf("gibberdet: unsupported table %T", m.table)
+	}
+	return nil
+}
+
+// decode reads a model in any version of the format. If legacySize is not
+// negative, it is used as the size of a version 1 body instead of the size in
+// the header; see UnmarshalBinary.
+func (m *Model) decode(mr *modelReader, legacySize int64) error {
+	magic := mr.next(len(formatMagic))
+	if mr.err != nil || string(magic) != formatMagic {
+		return ErrBadMagic
+	}
+
+	sz := mr.uint32()
+	if mr.err != nil {
+		return mr.err
+	}
+
+	var meta map[string]string
+	var bodySize = int64(sz)
+	if sz == formatMarker {
+		if version := mr.uint32(); mr.err == nil && version != FormatVersion {
+			return fmt.Errorf("gibberdet: unsupported model format version %d", version)
+		}
+
+		count := mr.uint32()
+		for i := uint32(0); i < count && mr.err == nil; i++ {
+			k := mr.bytes(uint64(mr.uint32()))
+			v := mr.bytes(uint64(mr.uint32()))
+			if meta == nil {
+				meta = make(map[string]string)
+			}
+			meta[string(k)] = string(v)
+		}
+		bodySize = int64(mr.uint32())
+		if mr.err != nil {
+			return mr.err
+		}
+
+	} else if legacySize >= 0 {
+		bodySize = legacySize
+	}
+
+	mr.limit = bodySize
+	alpha, table, err := decodeBody(mr)
+	if err != nil {
+		return err
+	}
+	if mr.limit != 0 {
+		return fmt.Errorf("gibberdet: unexpected data after table")
+	}
+	mr.limit = -1
+
+	if sz == formatMarker && mr.hash != nil {
+		want := mr.hash.Sum(nil)
+		mr.hash = nil
+		if got := mr.next(sha256.Size); mr.err != nil {
+			return mr.err
+		} else if !bytes.Equal(got, want) {
+			return ErrChecksum
+		}
+	} else if sz == formatMarker {
+		mr.next(sha256.Size)
+	}
+
+	if !mr.atEOF() {
+		return fmt.Errorf("gibberdet: unexpected data after model")
+	}
+
+	return m.decoded(alpha, table, meta)
+}
+
+func decodeBody(mr *modelReader) (alpha Alphabet, table gramTable, err error) {
+	alphaData := mr.bytes(uint64(mr.uint32()))
+	if mr.err != nil {
+		return nil, nil, mr.err
+	}
+	if err := unmarshalAlphabet(alphaData, &alpha); err != nil {
+		return nil, nil, err
+	}
+	n := alpha.Len()
+
+	gramSz := mr.uint32()
+	if mr.err != nil {
+		return nil, nil, mr.err
+	}
+
+	if gramSz == tableMarker {
+		switch kind := mr.uint32(); kind {
+		case tableKindSparse:
+			table = readSparseTable(mr, n)
+		case tableKindFloat32:
+			table = readFloat32Table(mr, n)
+		case tableKindQuant16:
+			table = readQuant16Table(mr, n)
+		case tableKindQuant8:
+			table = readQuant8Table(mr, n)
+		default:
+			if mr.err != nil {
+				return nil, nil, mr.err
+			}
+			return nil, nil, fmt.Errorf("gibberdet: unknown table kind %d", kind)
+		}
+
+	} else {
+		if uint64(gramSz) != uint64(n)*uint64(n) {
+			return nil, nil, fmt.Errorf("gibberdet: gram size %d does not match alphabet size %d squared", gramSz, n)
+		}
+		table = &denseTable{gram: mr.float64s(uint64(gramSz), "gram data"), n: n}
+	}
+
+	if mr.err != nil {
+		return nil, nil, mr.err
+	}
+	return alpha, table, nil
+}
+
+// modelWriter writes the little endian values in a model through a buffer.
+// Errors are kept by the bufio.Writer and returned by Flush.
+type modelWriter struct {
+	w   *bufio.Writer
+	enc [8]byte
+}
+
+func newModelWriter(w io.Writer) *modelWriter {
+	return &modelWriter{w: bufio.NewWriterSize(w, streamBufferSize)}
+}
+
+func (mw *modelWriter) uint16(v uint16) {
+	binary.LittleEndian.PutUint16(mw.enc[:], v)
+	mw.w.Write(mw.enc[:2])
+}
+
+func (mw *modelWriter) uint32(v uint32) {
+	binary.LittleEndian.PutUint32(mw.enc[:], v)
+	mw.w.Write(mw.enc[:4])
+}
+
+func (mw *modelWriter) uint64(v uint64) {
+	binary.LittleEndian.PutUint64(mw.enc[:], v)
+	mw.w.Write(mw.enc[:])
+}
+
+func (mw *modelWriter) float64(v float64) { mw.uint64(math.Float64bits(v)) }
+func (mw *modelWriter) bytes(b []byte)    { mw.w.Write(b) }
+func (mw *modelWriter) string(s string)   { mw.w.WriteString(s) }
+
+// modelReader reads the little endian values in a model from a stream or a
+// byte slice. The first error is kept in err, after which reads return zero
+// values. Running out of input is reported as ErrTruncated.
+//
+// When reading from a stream, slices are read and allocated in chunks as the
+// input arrives, so corrupt sizes can't cause allocations much larger than the
+// input.
+type modelReader struct {
+	r   *bufio.Reader
+	n   int64
+	err error
+
+	// If not nil, values are read from data instead of r.
+	data []byte
+
+	// If true, slices of values that are aligned in data are returned as
+	// views of data instead of copies; see NewModelFromBytes.
+	noCopy bool
+
+	// If not negative, reading more than this many bytes is an error.
+	limit int64
+
+	// If not nil, everything read is written to hash.
+	hash hash.Hash
+
+	scratch []byte
+}
+
+func newModelReader(r io.Reader, checksum bool) *modelReader {
+	mr := &modelReader{
+		r:       bufio.NewReaderSize(r, streamBufferSize),
+		limit:   -1,
+		scratch: make([]byte, streamBufferSize),
+	}
+	if checksum {
+		mr.hash = sha256.New()
+	}
+	return mr
+}
+
+func newModelReaderBytes(data []byte, noCopy bool) *modelReader {
+	return &modelReader{data: data, noCopy: noCopy, limit: -1}
+}
+
+// next reads n bytes. When reading from a stream, n must be no more than
+// streamBufferSize, and the result is only valid until the next read.
+func (mr *modelReader) next(n int) []byte {
+	if mr.err != nil {
+		return nil
+	}
+	if mr.limit >= 0 {
+		if int64(n) > mr.limit {
+			mr.err = ErrTruncated
+			return nil
+		}
+		mr.limit -= int64(n)
+	}
+
+	var buf []byte
+	if mr.data != nil {
+		if pos := int(mr.n); len(mr.data)-pos < n {
+			mr.err = ErrTruncated
+			buf = mr.data[pos:]
+		} else {
+			buf = mr.data[pos : pos+n]
+		}
+
+	} else {
+		read, err := io.ReadFull(mr.r, mr.scratch[:n])
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			mr.err = ErrTruncated
+		} else if err != nil {
+			mr.err = err
+		}
+		buf = mr.scratch[:read]
+	}
+
+	mr.n += int64(len(buf))
+	if mr.hash != nil {
+		mr.hash.Write(buf)
+	}
+	return buf
+}
+
+func (mr *modelReader) atEOF() bool {
+	if mr.data != nil {
+		return int(mr.n) == len(mr.data)
+	}
+	_, err := mr.r.Peek(1)
+	return err == io.EOF
+}
+
+// fits returns true if count values of the given size are left in data.
+func (mr *modelReader) fits(count uint64, size int) bool {
+	return mr.data != nil && count <= uint64(len(mr.data)-int(mr.n))/uint64(size)
+}
+
+// chunks calls fn with the bytes for count values of the given size, in one
+// or more chunks. It does not call fn after an error.
+func (mr *modelReader) chunks(count uint64, size int, fn func(b []byte)) {
+	if mr.data != nil {
+		if !mr.fits(count, size) {
+			mr.err = ErrTruncated
+			return
+		}
+		if b := mr.next(int(count) * size); mr.err == nil {
+			fn(b)
+		}
+		return
+	}
+	for left := count; left > 0 && mr.err == nil; {
+		chunk := streamChunk(left, size)
+		if b := mr.next(chunk * size); mr.err == nil {
+			fn(b)
+		}
+		left -= uint64(chunk)
+	}
+}
+
+// capacity returns the capacity to allocate for count values of the given
+// size before reading them.
+func (mr *modelReader) capacity(count uint64, size int) int {
+	if mr.fits(count, size) {
+		return int(count)
+	}
+	return streamChunk(count, 1)
+}
+
+// view returns the bytes for count values of the given size if they can be
+// used in place; see viewFloat64s.
+func (mr *modelReader) view(count uint64, size int) (b []byte, ok bool) {
+	if !mr.noCopy || !nativeLittleEndian || count == 0 || !mr.fits(count, size) {
+		return nil, false
+	}
+	if uintptr(unsafe.Pointer(&mr.data[mr.n]))%uintptr(size) != 0 {
+		return nil, false
+	}
+	b = mr.next(int(count) * size)
+	return b, mr.err == nil
+}
+
+func (mr *modelReader) uint32() uint32 {
+	b := mr.next(4)
+	if len(b) < 4 {
+		return 0
+	}
+	return binary.LittleEndian.Uint32(b)
+}
+
+func (mr *modelReader) bytes(n uint64) []byte {
+	if b, ok := mr.view(n, 1); ok {
+		return b
+	}
+	out := make([]byte, 0, mr.capacity(n, 1))
+	mr.chunks(n, 1, func(b []byte) {
+		out = append(out, b...)
+	})
+	return out
+}
+
+// float64s reads count values, and rejects any that are NaN or infinite.
+func (mr *modelReader) float64s(count uint64, what string) (out []float64) {
+	if b, ok := mr.view(count, 8); ok {
+		out = viewFloat64s(b)
+	} else {
+		out = make([]float64, 0, mr.capacity(count, 8))
+		mr.chunks(count, 8, func(b []byte) {
+			for i := 0; i < len(b); i += 8 {
+				out = append(out, math.Float64frombits(binary.LittleEndian.Uint64(b[i:])))
+			}
+		})
+	}
+	if mr.err != nil {
+		return nil
+	}
+	for _, v := range out {
+		if err := checkFinite(what, v); err != nil {
+			mr.err = err
+			return nil
+		}
+	}
+	return out
+}
+
+// float32s reads count values, and rejects any that are NaN or infinite.
+func (mr *modelReader) float32s(count uint64, what string) (out []float32) {
+	if b, ok := mr.view(count, 4); ok {
+		out = viewFloat32s(b)
+	} else {
+		out = make([]float32, 0, mr.capacity(count, 4))
+		mr.chunks(count, 4, func(b []byte) {
+			for i := 0; i < len(b); i += 4 {
+				out = append(out, math.Float32frombits(binary.LittleEndian.Uint32(b[i:])))
+			}
+		})
+	}
+	if mr.err != nil {
+		return nil
+	}
+	for _, v := range out {
+		if err := checkFinite(what, float64(v)); err != nil {
+			mr.err = err
+			return nil
+		}
+	}
+	return out
+}
+
+func (mr *modelReader) uint32s(count uint64) (out []uint32) {
+	if b, ok := mr.view(count, 4); ok {
+		return viewUint32s(b)
+	}
+	out = make([]uint32, 0, mr.capacity(count, 4))
+	mr.chunks(count, 4, func(b []byte) {
+		for i := 0; i < len(b); i += 4 {
+			out = append(out, binary.LittleEndian.Uint32(b[i:]))
+		}
+	})
+	return out
+}
+
+func (mr *modelReader) uint16s(count uint64) (out []uint16) {
+	if b, ok := mr.view(count, 2); ok {
+		return viewUint16s(b)
+	}
+	out = make([]uint16, 0, mr.capacity(count, 2))
+	mr.chunks(count, 2, func(b []byte) {
+		for i := 0; i < len(b); i += 2 {
+			out = append(out, binary.LittleEndian.Uint16(b[i:]))
+		}
+	})
+	return out
+}
+
+// streamChunk returns the number of values of the given size to read at once,
+// out of the count that are left.
+func streamChunk(left uint64, size int) int {
+	max := uint64(streamBufferSize / size)
+	if left < max {
+		return int(left)
+	}
+	return int(max)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(b []byte) (n int, err error) {
+	n, err = c.w.Write(b)
+	c.n += int64(n)
+	return n, err
+}
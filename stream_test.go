@@ -0,0 +1,193 @@
+package gibberdet
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/iotest"
+)
+
+func TestModelWriteTo(t *testing.T) {
+	m := loadTestModel(t, "test-cn.gibber").WithMetadata(map[string]string{MetaLanguage: "zh"})
+	for _, cm := range convertTestModel(t, m) {
+		s := cm.Storage()
+
+		var buf bytes.Buffer
+		n, err := cm.WriteTo(&buf)
+		if err != nil {
+			t.Fatal(s, err)
+		}
+		if n != int64(buf.Len()) {
+			t.Fatal(s, n, buf.Len())
+		}
+
+		// The body must be the same as version 1:
+		v1 := marshalV1(t, cm)
+		if !bytes.Contains(buf.Bytes(), v1[len(formatMagic):]) {
+			t.Fatal(s, "body does not match version 1")
+		}
+
+		var out Model
+		rn, err := out.ReadFrom(iotest.OneByteReader(bytes.NewReader(buf.Bytes())))
+		if err != nil {
+			t.Fatal(s, err)
+		}
+		if rn != n {
+			t.Fatal(s, rn, n)
+		}
+		if out.Storage() != s || out.Metadata()[MetaLanguage] != "zh" {
+			t.Fatal(s, out.Storage(), out.Metadata())
+		}
+		again, err := out.MarshalBinary()
+		if err != nil {
+			t.Fatal(s, err)
+		}
+		if !bytes.Equal(again, buf.Bytes()) {
+			t.Fatal(s, "round trip does not match")
+		}
+	}
+}
+
+// streamGolden holds the SHA-256 of each test model, with MetaLanguage set,
+// as encoded by MarshalBinary before WriteTo was added.
+var streamGolden = []struct {
+	name    string
+	storage Storage
+	sha256  string
+}{
+	{"oanc-en.gibber", StorageDense, "bbd02a34b8bb65c52f32e7e86082338f09aa3d8d36883181e5285d440aefbe73"},
+	{"oanc-en.gibber", StorageSparse, "1d39330cb8ff0ceee80e399ddc536eef8de3919c615c6b26c0b883989a397593"},
+	{"oanc-en.gibber", StorageFloat32, "69516fb14f3cd3f99e06c1375a3699817f45ccbc8471e719c125c40971563799"},
+	{"oanc-en.gibber", StorageQuant16, "c891b50c1e32de6d6f22afcf9f16ba5e3aa73c3ec67a116a91ca6fedbbe7b4e3"},
+	{"oanc-en.gibber", StorageQuant8, "43be02108026e0bb50929bf7f690462e9655c92a5bcb9c0b6926ae677d286ec2"},
+	{"test-cn.gibber", StorageDense, "9825bc312f9e94e4896b1276347a3d6cf8f6f4280fe66f0fc6428b85083b49fa"},
+	{"test-cn.gibber", StorageSparse, "bdb93418b2c6b317d5a05e734a03e10d20e4fad84b2a293edec35b2bc10705b2"},
+	{"test-cn.gibber", StorageFloat32, "62b1d7408801d93a457902b69eaba93505e3f094ff00517d458f1f53d8a343f0"},
+	{"test-cn.gibber", StorageQuant16, "c97c3c221e6bbdd14db288906217ca9f3c19e9e5caded2f4fce0a72896ec08e1"},
+	{"test-cn.gibber", StorageQuant8, "8d0edb51109adbc44bb1a7869f74d3c67776905f188c19a266eb833e28ee03b1"},
+}
+
+func TestModelWriteToGolden(t *testing.T) {
+	lang := map[string]string{"oanc-en.gibber": "en", "test-cn.gibber": "zh"}
+	for _, tc := range streamGolden {
+		m := loadTestModel(t, tc.name).WithMetadata(map[string]string{MetaLanguage: lang[tc.name]})
+		cm, err := m.Convert(tc.storage)
+		if err != nil {
+			t.Fatal(tc.name, tc.storage, err)
+		}
+
+		var buf bytes.Buffer
+		if _, err := cm.WriteTo(&buf); err != nil {
+			t.Fatal(tc.name, tc.storage, err)
+		}
+		b, err := cm.MarshalBinary()
+		if err != nil {
+			t.Fatal(tc.name, tc.storage, err)
+		}
+		for _, enc := range [][]byte{buf.Bytes(), b} {
+			if sum := fmt.Sprintf("%x", sha256.Sum256(enc)); sum != tc.sha256 {
+				t.Fatal(tc.name, tc.storage, sum)
+			}
+		}
+	}
+}
+
+func TestModelReadFromV1(t *testing.T) {
+	for _, name := range []string{"oanc-en.gibber", "gutenberg-en.gibber", "test-cn.gibber"} {
+		b, err := ioutil.ReadFile("testdata/" + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var m Model
+		if n, err := m.ReadFrom(bytes.NewReader(b)); err != nil {
+			t.Fatal(name, err)
+		} else if n != int64(len(b)) {
+			t.Fatal(name, n, len(b))
+		}
+		want := loadTestModel(t, name)
+		if m.GibberScore("hello world") != want.GibberScore("hello world") {
+			t.Fatal(name)
+		}
+	}
+}
+
+func TestModelReadFromErrors(t *testing.T) {
+	m := loadTestModel(t, "test-cn.gibber")
+	sparse, err := m.Convert(StorageSparse)
+	if err != nil {
+		t.Fatal(err)
+	}
+	v2, err := sparse.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var out Model
+	if _, err := out.ReadFrom(bytes.NewReader([]byte("gibber"))); !errors.Is(err, ErrBadMagic) {
+		t.Fatal(err)
+	}
+	for _, i := range []int{len(formatMagic) + 2, 40, len(v2) / 2, len(v2) - 1} {
+		if _, err := out.ReadFrom(bytes.NewReader(v2[:i])); !errors.Is(err, ErrTruncated) {
+			t.Fatal(i, err)
+		}
+	}
+
+	// The checksum is only known at the end:
+	bad := append([]byte(nil), v2...)
+	bad[len(bad)-sha256.Size-1] ^= 1
+	if _, err := out.ReadFrom(bytes.NewReader(bad)); !errors.Is(err, ErrChecksum) {
+		t.Fatal(err)
+	}
+
+	bad = append(append([]byte(nil), v2...), 0)
+	if _, err := out.ReadFrom(bytes.NewReader(bad)); err == nil {
+		t.Fatal()
+	}
+	bad = append(marshalV1(t, m), 0)
+	if _, err := out.ReadFrom(bytes.NewReader(bad)); err == nil {
+		t.Fatal()
+	}
+}
+
+func TestModelSaveLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gibberdet-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	m := loadTestModel(t, "oanc-en.gibber")
+	path := filepath.Join(dir, "model.gibber")
+	if err := m.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	saved, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := m.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(saved, want) {
+		t.Fatal("saved file does not match MarshalBinary")
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.GibberScore("hello world") != m.GibberScore("hello world") {
+		t.Fatal()
+	}
+
+	if _, err := Load(filepath.Join(dir, "missing")); !os.IsNotExist(err) {
+		t.Fatal(err)
+	}
+}
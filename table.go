@@ -0,0 +1,377 @@
+package gibberdet
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Storage selects how a Model stores its table of transition log
+// probabilities.
+type Storage int
+
+const (
+	// StorageAuto uses StorageSparse for alphabets with more than
+	// SparseAlphabetThreshold runes, and StorageDense otherwise.
+	StorageAuto Storage = iota
+
+	// StorageDense stores every transition; the table has Len()*Len() entries.
+	StorageDense
+
+	// StorageSparse stores only the transitions that were observed in
+	// training, plus a default for each row that is used for the rest.
+	StorageSparse
+
+	// StorageFloat32 is StorageDense with float32 instead of float64; half
+	// the size, with a negligible loss of precision.
+	StorageFloat32
+
+	// StorageQuant16 and StorageQuant8 quantize each row of the dense table
+	// linearly between the row's minimum and maximum log probability, using
+	// 16 or 8 bits per transition. Use MeasureAccuracy to check the effect
+	// on the scores.
+	StorageQuant16
+	StorageQuant8
+)
+
+var storageNames = map[Storage]string{
+	StorageAuto:    "auto",
+	StorageDense:   "dense",
+	StorageSparse:  "sparse",
+	StorageFloat32: "float32",
+	StorageQuant16: "quant16",
+	StorageQuant8:  "quant8",
+}
+
+// ParseStorage accepts the names returned by Storage.String().
+func ParseStorage(s string) (Storage, error) {
+	for k, v := range storageNames {
+		if v == s {
+			return k, nil
+		}
+	}
+	return 0, fmt.Errorf("gibberdet: unknown storage %q", s)
+}
+
+// Alphabets larger than this use StorageSparse when StorageAuto is selected.
+// A dense table for an alphabet of this size is 8MB.
+const SparseAlphabetThreshold = 1024
+
+func (s Storage) String() string {
+	if name, ok := storageNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("Storage(%d)", int(s))
+}
+
+func (s Storage) resolve(alphaLen int) Storage {
+	if s == StorageAuto {
+		if alphaLen > SparseAlphabetThreshold {
+			return StorageSparse
+		}
+		return StorageDense
+	}
+	return s
+}
+
+// gramTable is implemented by each of the ways a Model can store its
+// transition log probabilities.
+type gramTable interface {
+	// logProb returns the log probability of the transition from the rune at
+	// alphabet index 'from' to the rune at alphabet index 'to'.
+	logProb(from, to int) float64
+
+	storage() Storage
+}
+
+// encodedTable is implemented by all tables except denseTable, which uses
+// the original encoding.
+type encodedTable interface {
+	gramTable
+	kind() uint32
+
+	// size returns the number of bytes written by marshal.
+	size() uint64
+	marshal(mw *modelWriter)
+}
+
+// Encoded table kinds. These follow tableMarker in place of the gram size in
+// the binary encoding; dense tables use the original encoding and so have no
+// kind.
+const (
+	tableMarker = 0xFFFFFFFF
+
+	tableKindSparse  uint32 = 1
+	tableKindFloat32 uint32 = 2
+	tableKindQuant16 uint32 = 3
+	tableKindQuant8  uint32 = 4
+)
+
+// newTable converts a dense table of log probabilities into the requested
+// storage. Storage must already be resolved.
+func newTable(gram []float64, n int, storage Storage) (gramTable, error) {
+	switch storage {
+	case StorageDense:
+		return &denseTable{gram: gram, n: n}, nil
+	case StorageSparse:
+		return sparseFromDense(gram, n), nil
+	case StorageFloat32:
+		return newFloat32Table(gram, n), nil
+	case StorageQuant16:
+		return newQuant16Table(gram, n), nil
+	case StorageQuant8:
+		return newQuant8Table(gram, n), nil
+	default:
+		return nil, fmt.Errorf("gibberdet: unsupported storage %s", storage)
+	}
+}
+
+// expandTable returns every log probability in the table as a dense slice.
+func expandTable(table gramTable, n int) []float64 {
+	if dense, ok := table.(*denseTable); ok {
+		gram := make([]float64, len(dense.gram))
+		copy(gram, dense.gram)
+		return gram
+	}
+	gram := make([]float64, n*n)
+	for from := 0; from < n; from++ {
+		for to := 0; to < n; to++ {
+			gram[from*n+to] = table.logProb(from, to)
+		}
+	}
+	return gram
+}
+
+type denseTable struct {
+	gram []float64
+	n    int
+}
+
+func (d *denseTable) logProb(from, to int) float64 {
+	return d.gram[from*d.n+to]
+}
+
+func (d *denseTable) storage() Storage { return StorageDense }
+
+// sparseTable stores rows in compressed sparse row form: the columns and
+// values for row i are at cols[rows[i]:rows[i+1]] and vals[rows[i]:rows[i+1]],
+// with the columns sorted in ascending order. Missing columns use the row's
+// default.
+type sparseTable struct {
+	n        int
+	defaults []float64
+	rows     []uint32
+	cols     []uint32
+	vals     []float64
+}
+
+func (s *sparseTable) storage() Storage { return StorageSparse }
+
+func (s *sparseTable) kind() uint32 { return tableKindSparse }
+
+func (s *sparseTable) logProb(from, to int) float64 {
+	lo, hi := int(s.rows[from]), int(s.rows[from+1])
+	col := uint32(to)
+
+	// Hand-rolled sort.Search; the closure call makes a measurable
+	// difference here:
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if s.cols[mid] < col {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < int(s.rows[from+1]) && s.cols[lo] == col {
+		return s.vals[lo]
+	}
+	return s.defaults[from]
+}
+
+// sparseCount is a single observed transition count accumulated by a Trainer
+// using StorageSparse.
+type sparseCount struct {
+	col   uint32
+	count float64
+}
+
+// newSparseTable normalises the observed counts into log probabilities. Every
+// transition that was not observed is assumed to have been seen pairWeight
+// times.
+func newSparseTable(n int, counts map[int]float64, pairWeight float64) (*sparseTable, error) {
+	rowCounts := make([][]sparseCount, n)
+	rowSums := make([]float64, n)
+	for k, c := range counts {
+		from, to := k/n, k%n
+		rowCounts[from] = append(rowCounts[from], sparseCount{col: uint32(to), count: c})
+		rowSums[from] += c
+	}
+
+	st := &sparseTable{
+		n:        n,
+		defaults: make([]float64, n),
+		rows:     make([]uint32, n+1),
+		cols:     make([]uint32, 0, len(counts)),
+		vals:     make([]float64, 0, len(counts)),
+	}
+
+	for from, row := range rowCounts {
+		sort.Slice(row, func(i, j int) bool { return row[i].col < row[j].col })
+
+		sum := rowSums[from] + pairWeight*float64(n)
+		def, ok := normLogProb(pairWeight, sum)
+		if !ok {
+			return nil, fmt.Errorf("gibberdet: NaN detected in row %d", from)
+		}
+		st.defaults[from] = def
+
+		for _, c := range row {
+			v, ok := normLogProb(pairWeight+c.count, sum)
+			if !ok {
+				return nil, fmt.Errorf("gibberdet: NaN detected in row %d", from)
+			}
+			st.cols = append(st.cols, c.col)
+			st.vals = append(st.vals, v)
+		}
+		st.rows[from+1] = uint32(len(st.cols))
+	}
+
+	return st, nil
+}
+
+// sparseFromDense uses the most common value in each row as the row's
+// default. For a table compiled by a Trainer, this is the value for the
+// transitions that were never observed.
+func sparseFromDense(gram []float64, n int) *sparseTable {
+	st := &sparseTable{
+		n:        n,
+		defaults: make([]float64, n),
+		rows:     make([]uint32, n+1),
+	}
+
+	freq := make(map[float64]int)
+	for from := 0; from < n; from++ {
+		row := gram[from*n : from*n+n]
+		for k := range freq {
+			delete(freq, k)
+		}
+		var def float64
+		var best int
+		for _, v := range row {
+			freq[v]++
+			if freq[v] > best {
+				def, best = v, freq[v]
+			}
+		}
+		st.defaults[from] = def
+
+		for to, v := range row {
+			if v != def {
+				st.cols = append(st.cols, uint32(to))
+				st.vals = append(st.vals, v)
+			}
+		}
+		st.rows[from+1] = uint32(len(st.cols))
+	}
+	return st
+}
+
+// normLogProb converts a count into a log probability the same way
+// Trainer.Compile does for dense tables, returning ok=false for NaN.
+func normLogProb(count, sum float64) (v float64, ok bool) {
+	v = math.Log(count / sum)
+	if math.IsNaN(v) {
+		return 0, false
+	}
+	if math.IsInf(v, 0) {
+		v = math.SmallestNonzeroFloat64
+	}
+	return v, true
+}
+
+// transitionProb converts a stored log probability back into a probability.
+// Transitions that were never seen are stored as a positive sentinel (see
+// normLogProb), so any log probability above 0 is a probability of 0.
+func transitionProb(logProb float64) float64 {
+	if logProb > 0 {
+		return 0
+	}
+	return math.Exp(logProb)
+}
+
+func (s *sparseTable) size() uint64 {
+	return 4 + uint64(s.n)*12 + uint64(len(s.cols))*12
+}
+
+func (s *sparseTable) marshal(mw *modelWriter) {
+	mw.uint32(uint32(len(s.cols)))
+	for _, f := range s.defaults {
+		mw.float64(f)
+	}
+	for _, r := range s.rows[1:] {
+		mw.uint32(r)
+	}
+	for _, c := range s.cols {
+		mw.uint32(c)
+	}
+	for _, f := range s.vals {
+		mw.float64(f)
+	}
+}
+
+func readSparseTable(mr *modelReader, n int) *sparseTable {
+	nnz := mr.uint32()
+	st := &sparseTable{
+		n:        n,
+		defaults: mr.float64s(uint64(n), "sparse table"),
+		rows:     append([]uint32{0}, mr.uint32s(uint64(n))...),
+	}
+	if mr.err != nil {
+		return nil
+	}
+	if st.rows[n] != nnz {
+		mr.err = fmt.Errorf("gibberdet: sparse table size mismatch")
+		return nil
+	}
+	st.cols = mr.uint32s(uint64(nnz))
+	st.vals = mr.float64s(uint64(nnz), "sparse table")
+	if mr.err != nil {
+		return nil
+	}
+	if err := st.check(); err != nil {
+		mr.err = err
+		return nil
+	}
+	return st
+}
+
+// check returns an error if the rows and columns are not a valid compressed
+// sparse row table of size n. It does not check the values.
+func (s *sparseTable) check() error {
+	if len(s.defaults) != s.n || len(s.rows) != s.n+1 || s.rows[0] != 0 {
+		return fmt.Errorf("gibberdet: sparse table size mismatch")
+	}
+	nnz := uint32(len(s.cols))
+	if len(s.vals) != len(s.cols) || s.rows[s.n] != nnz {
+		return fmt.Errorf("gibberdet: sparse table size mismatch")
+	}
+	for i := 1; i <= s.n; i++ {
+		if s.rows[i] < s.rows[i-1] || s.rows[i] > nnz {
+			return fmt.Errorf("gibberdet: sparse table row %d out of range", i-1)
+		}
+	}
+	for _, c := range s.cols {
+		if int(c) >= s.n {
+			return fmt.Errorf("gibberdet: sparse table column %d out of range", c)
+		}
+	}
+	for i := 0; i < s.n; i++ {
+		for j := s.rows[i] + 1; j < s.rows[i+1]; j++ {
+			if s.cols[j] <= s.cols[j-1] {
+				return fmt.Errorf("gibberdet: sparse table row %d is not sorted", i)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+# Writes parity.json, which is checked by TestCompatPythonParity.
+#
+# This follows gib_detect_train.py and gib_detect.py from rrenaud's
+# Gibberish-Detector, which are written for Python 2 and so work on bytes:
+# only ASCII letters are lowercased, and each byte of a multi-byte character
+# is dropped on its own. It runs under Python 2 or 3:
+#
+#	python reference.py > parity.json
+import json
+import math
+
+accepted_chars = 'abcdefghijklmnopqrstuvwxyz '
+pos = dict([(char, idx) for idx, char in enumerate(accepted_chars)])
+
+
+def lines(path):
+    with open(path, 'rb') as f:
+        for line in f:
+            yield line.decode('latin-1')
+
+
+def normalize(line):
+    out = []
+    for c in line:
+        if 'A' <= c <= 'Z':
+            c = c.lower()
+        if c in accepted_chars:
+            out.append(c)
+    return out
+
+
+def ngram(n, l):
+    filtered = normalize(l)
+    for start in range(0, len(filtered) - n + 1):
+        yield ''.join(filtered[start:start + n])
+
+
+def avg_transition_prob(l, log_prob_mat):
+    log_prob = 0.0
+    transition_ct = 0
+    for a, b in ngram(2, l):
+        log_prob += log_prob_mat[pos[a]][pos[b]]
+        transition_ct += 1
+    return math.exp(log_prob / (transition_ct or 1))
+
+
+def samples(path):
+    return [line.rstrip('\n') for line in lines(path)]
+
+
+k = len(accepted_chars)
+counts = [[10 for i in range(k)] for i in range(k)]
+for line in lines('corpus.txt'):
+    for a, b in ngram(2, line):
+        counts[pos[a]][pos[b]] += 1
+
+for i, row in enumerate(counts):
+    s = float(sum(row))
+    for j in range(len(row)):
+        row[j] = math.log(row[j] / s)
+
+good = samples('good.txt')
+bad = samples('bad.txt')
+good_probs = [avg_transition_prob(l, counts) for l in good]
+bad_probs = [avg_transition_prob(l, counts) for l in bad]
+assert min(good_probs) > max(bad_probs)
+thresh = (min(good_probs) + max(bad_probs)) / 2
+
+scores = []
+for l in good + bad + samples('edge.txt'):
+    # Samples are written as Latin-1 so that each byte is kept as it is:
+    scores.append([l, avg_transition_prob(l, counts)])
+
+print(json.dumps({'mat': counts, 'thresh': thresh, 'scores': scores}, indent=1))
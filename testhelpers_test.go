@@ -0,0 +1,20 @@
+package gibberdet
+
+import "testing"
+
+// testStorages holds every Storage a model can be converted to.
+var testStorages = []Storage{StorageDense, StorageSparse, StorageFloat32, StorageQuant16, StorageQuant8}
+
+// convertTestModel returns m converted to each of testStorages, in order.
+func convertTestModel(t testing.TB, m *Model) []*Model {
+	t.Helper()
+	out := make([]*Model, len(testStorages))
+	for i, s := range testStorages {
+		cm, err := m.Convert(s)
+		if err != nil {
+			t.Fatal(s, err)
+		}
+		out[i] = cm
+	}
+	return out
+}
@@ -3,18 +3,21 @@
 package main
 
 import (
-	"archive/zip"
 	"bufio"
 	"bytes"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
 	"os"
-	"path/filepath"
-	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/shabbyrobe/gibberdet"
@@ -28,11 +31,29 @@ func main() {
 
 func run() error {
 	if len(os.Args) < 2 {
-		return fmt.Errorf("usage: tool.go (train|test|gib|gibfile|oanc)")
+		return fmt.Errorf("usage: tool.go (alpha|train|convert|gen|pyimport|pyexport|export|import|diff|info|test|gib|gibfile|oanc)")
 	}
 	switch os.Args[1] {
+	case "alpha":
+		return alpha(os.Args[2:])
 	case "train":
 		return train(os.Args[2:])
+	case "convert":
+		return convert(os.Args[2:])
+	case "gen":
+		return gen(os.Args[2:])
+	case "pyimport":
+		return pyimport(os.Args[2:])
+	case "pyexport":
+		return pyexport(os.Args[2:])
+	case "export":
+		return export(os.Args[2:])
+	case "import":
+		return importMatrix(os.Args[2:])
+	case "diff":
+		return diff(os.Args[2:])
+	case "info":
+		return info(os.Args[2:])
 	case "test":
 		return test(os.Args[2:])
 	case "gib":
@@ -46,27 +67,124 @@ func run() error {
 	}
 }
 
+func alpha(args []string) error {
+	var minCount int64
+	var topN int
+	var coverage float64
+	var letters, verbose bool
+
+	fs := flag.NewFlagSet("", 0)
+	fs.Int64Var(&minCount, "min", 0, "Discard runes seen fewer than this many times")
+	fs.IntVar(&topN, "top", 0, "Keep at most this many of the most common runes")
+	fs.Float64Var(&coverage, "coverage", 0, "Keep the most common runes until they cover this fraction of the input, i.e. 0.999")
+	fs.BoolVar(&letters, "letters", false, "Only keep letters, marks and spaces")
+	fs.BoolVar(&verbose, "v", false, "Print every rune kept and discarded")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	args = fs.Args()
+	if len(args) < 2 {
+		return fmt.Errorf("usage: tool.go alpha [-min=<n>] [-top=<n>] [-coverage=<f>] [-letters] [-v] <infile>... <outfile>")
+	}
+	inFiles, outFile := args[:len(args)-1], args[len(args)-1]
+
+	ab := gibberdet.NewAlphabetBuilder()
+	for _, inFile := range inFiles {
+		f, err := os.Open(inFile)
+		if err != nil {
+			return err
+		}
+		err = ab.Add(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	opts := []gibberdet.AlphabetBuildOption{
+		gibberdet.AlphabetMinCount(minCount),
+		gibberdet.AlphabetTopN(topN),
+		gibberdet.AlphabetCoverage(coverage),
+	}
+	if letters {
+		opts = append(opts, gibberdet.AlphabetIncludeCategories(unicode.L, unicode.M, unicode.Zs))
+	}
+
+	a, report := ab.Build(opts...)
+	if verbose {
+		fmt.Print(report)
+	} else {
+		fmt.Printf("kept %d runes, discarded %d, coverage %0.4f%% of %d\n",
+			len(report.Kept), len(report.Discarded), report.Coverage*100, report.Total)
+	}
+
+	return ioutil.WriteFile(outFile, []byte(string(a.Runes())), 0644)
+}
+
 func train(args []string) error {
 	var alphaKind = "asciialnum"
 	var alphaFile string
+	var include, exclude stringList
+	var filter string
+	var minRow int64
+	var other bool
+	var encoding string
+	var meta stringList
+	var storage string
+	var compat string
+	var countsFile string
 
 	fs := flag.NewFlagSet("", 0)
 	fs.StringVar(&alphaKind, "alphakind", "asciialnum", ""+
-		"Alphabet to use. Accepts 'asciialpha', 'asciialnum', 'asciifile' or 'runefile'")
+		"Alphabet to use. Accepts 'asciialpha', 'asciialnum', 'asciialphafolded', 'asciialnumfolded', "+
+		"'bytes', 'python', 'asciifile', 'runefile', or an alphabet spec like 'a-zA-Z0-9_\\- ', '[aA][bB][0-9]' or '\\p{Han}'")
 	fs.StringVar(&alphaFile, "alphafile", "", ""+
 		"File containing alphabet")
+	fs.Var(&include, "include", "Only train from files matching this glob (can pass multiple)")
+	fs.Var(&exclude, "exclude", "Skip files matching this glob (can pass multiple)")
+	fs.StringVar(&storage, "storage", "auto", ""+
+		"Transition storage. Accepts 'auto', 'dense', 'sparse', 'float32', 'quant16' or 'quant8'")
+	fs.Int64Var(&minRow, "minrow", 100, "Warn about runes with fewer than this many observed transitions")
+	fs.BoolVar(&other, "other", false, "Learn transitions to and from runes outside the alphabet as a single OTHER symbol")
+	fs.Var(&meta, "meta", "Add 'key=value' to the model's metadata, i.e. 'language=en' (can pass multiple)")
+	fs.StringVar(&encoding, "encoding", "utf-8", ""+
+		"Encoding of the input. Accepts 'utf-8', 'iso-8859-1', 'windows-1252' or 'iso-8859-15'")
+	fs.StringVar(&filter, "filter", "", ""+
+		"Strip markup from input. Accepts 'html', 'xml', 'markdown' or 'auto' (choose by file extension)")
+	fs.StringVar(&compat, "compat", "none", ""+
+		"Follow the rules of another implementation. Accepts 'none' or 'python'")
+	fs.StringVar(&countsFile, "counts", "", ""+
+		"Also write the raw transition counts to this file, as CSV, or TSV if it ends in '.tsv'")
 	if err := fs.Parse(args); err != nil {
 		return err
 	}
 
 	args = fs.Args()
-	if len(args) != 2 {
+	if len(args) < 2 {
 		return fmt.Errorf(
-			"usage: tool.go build -alphakind (asciialnum|asciialpha|asciifile|runefile) " +
-				"-alphafile=<alphafile> <infile> <outfile>")
+			"usage: tool.go train -alphakind (asciialnum|asciialpha|asciialnumfolded|asciialphafolded|bytes|python|asciifile|runefile|<spec>) " +
+				"-alphafile=<alphafile> [-include=<glob>] [-exclude=<glob>] " +
+				"[-filter=(html|xml|markdown|auto)] [-compat=(none|python)] <infile|indir>... <outfile>")
+	}
+
+	var chooseFilter func(name string) gibberdet.InputFilter
+	switch filter {
+	case "":
+	case "auto":
+		chooseFilter = gibberdet.FilterByExt
+	case "html", "xml", "markdown":
+		f := map[string]gibberdet.InputFilter{
+			"html":     gibberdet.StripHTML,
+			"xml":      gibberdet.StripXML,
+			"markdown": gibberdet.StripMarkdown,
+		}[filter]
+		chooseFilter = func(string) gibberdet.InputFilter { return f }
+	default:
+		return fmt.Errorf("unknown filter %q", filter)
 	}
 
-	inFile, outFile := args[0], args[1]
+	inFiles, outFile := args[:len(args)-1], args[len(args)-1]
 
 	var a gibberdet.Alphabet
 	switch alphaKind {
@@ -74,6 +192,14 @@ func train(args []string) error {
 		a = gibberdet.ASCIIAlnum
 	case "asciialpha":
 		a = gibberdet.ASCIIAlpha
+	case "asciialnumfolded":
+		a = gibberdet.ASCIIAlnumFolded
+	case "asciialphafolded":
+		a = gibberdet.ASCIIAlphaFolded
+	case "bytes":
+		a = gibberdet.ByteAlphabet
+	case "python":
+		a = gibberdet.PythonAlphabet
 	case "asciifile", "runefile":
 		af, err := os.Open(alphaFile)
 		if err != nil {
@@ -91,28 +217,392 @@ func train(args []string) error {
 			return err
 		}
 		af.Close()
+	default:
+		var err error
+		a, err = gibberdet.ParseAlphabetSpec(alphaKind)
+		if err != nil {
+			return err
+		}
+	}
+	if other {
+		a = gibberdet.WithOther(a)
+	}
+
+	st, err := gibberdet.ParseStorage(storage)
+	if err != nil {
+		return err
+	}
+	inEnc, err := gibberdet.ParseEncoding(encoding)
+	if err != nil {
+		return err
+	}
+	cm, err := gibberdet.ParseCompatMode(compat)
+	if err != nil {
+		return err
+	}
+
+	var kv []string
+	for _, m := range meta {
+		parts := strings.SplitN(m, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("-meta must be 'key=value', found %q", m)
+		}
+		kv = append(kv, parts...)
+	}
+
+	tr := gibberdet.NewTrainer(a,
+		gibberdet.TrainerStorage(st),
+		gibberdet.TrainerEncoding(inEnc),
+		gibberdet.TrainerCompat(cm),
+		gibberdet.TrainerMetadata(kv...))
+	for _, inFile := range inFiles {
+		if err := tr.AddPath(inFile,
+			gibberdet.CorpusInclude(include...),
+			gibberdet.CorpusExclude(exclude...),
+			gibberdet.CorpusFilter(chooseFilter),
+			gibberdet.CorpusProgress(printCorpusProgress),
+		); err != nil {
+			return err
+		}
+	}
+
+	stats := tr.Stats()
+	fmt.Print(stats)
+	for _, row := range stats.Unreliable(minRow) {
+		fmt.Printf("warning: only %d transitions from %q\n", row.Observations, row.Rune)
+	}
+
+	if countsFile != "" {
+		if err := writeMatrix(countsFile, a, tr.Counts()); err != nil {
+			return err
+		}
+	}
+
+	m, err := tr.Compile()
+	if err != nil {
+		return err
+	}
+
+	return m.Save(outFile)
+}
+
+func convert(args []string) error {
+	var storage, samplesFile string
+
+	fs := flag.NewFlagSet("", 0)
+	fs.StringVar(&storage, "storage", "auto", ""+
+		"Transition storage. Accepts 'auto', 'dense', 'sparse', 'float32', 'quant16' or 'quant8'")
+	fs.StringVar(&samplesFile, "samples", "", ""+
+		"File containing one sample per line, used to report the accuracy of the converted model")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	args = fs.Args()
+	if len(args) != 2 {
+		return fmt.Errorf("usage: tool.go convert -storage=<storage> [-samples=<file>] <inmodel> <outmodel>\n" +
+			"models with a '.json' extension are read or written as JSON")
+	}
+
+	st, err := gibberdet.ParseStorage(storage)
+	if err != nil {
+		return err
+	}
+
+	bts, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	var m gibberdet.Model
+	if strings.HasSuffix(args[0], ".json") {
+		err = json.Unmarshal(bts, &m)
+	} else {
+		err = m.UnmarshalBinary(bts)
+	}
+	if err != nil {
+		return err
+	}
+
+	out, err := m.Convert(st)
+	if err != nil {
+		return err
+	}
+
+	if samplesFile != "" {
+		samples, err := readStringList(samplesFile)
+		if err != nil {
+			return err
+		}
+		fmt.Println(gibberdet.MeasureAccuracy(&m, out, samples))
+	}
+
+	var enc []byte
+	if strings.HasSuffix(args[1], ".json") {
+		enc, err = json.MarshalIndent(out, "", "  ")
+	} else {
+		enc, err = out.MarshalBinary()
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Printf("%s (%d bytes) -> %s (%d bytes)\n", m.Storage(), len(bts), out.Storage(), len(enc))
+
+	return ioutil.WriteFile(args[1], enc, 0644)
+}
+
+func gen(args []string) error {
+	var pkg, name string
+	fs := flag.NewFlagSet("", 0)
+	fs.StringVar(&pkg, "pkg", "models", "Package name")
+	fs.StringVar(&name, "name", "Model", "Name of the exported function that returns the model")
+	fs.Parse(args)
+
+	args = fs.Args()
+	if len(args) != 2 {
+		return fmt.Errorf("usage: tool.go gen [-pkg=<pkg>] [-name=<name>] <model> <out.go>")
+	}
+
+	m, err := gibberdet.Load(args[0])
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := gibberdet.WriteGoSource(&buf, m, pkg, name); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(args[1], buf.Bytes(), 0644)
+}
+
+func pyimport(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: tool.go pyimport <gib_model.pki> <out>")
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	m, thresh, err := gibberdet.ReadPythonModel(f)
+	if err != nil {
+		return err
+	}
+	fmt.Println("threshold:", thresh)
+
+	return m.Save(args[1])
+}
+
+func pyexport(args []string) error {
+	var thresh float64
+	fs := flag.NewFlagSet("", 0)
+	fs.Float64Var(&thresh, "thresh", 0, "Threshold; defaults to the model's 'threshold' metadata")
+	fs.Parse(args)
+
+	args = fs.Args()
+	if len(args) != 2 {
+		return fmt.Errorf("usage: tool.go pyexport [-thresh=<thresh>] <model> <out.json>")
+	}
+
+	m, err := gibberdet.Load(args[0])
+	if err != nil {
+		return err
+	}
+	if thresh == 0 {
+		if thresh, err = strconv.ParseFloat(m.Metadata()[gibberdet.MetaThreshold], 64); err != nil {
+			return fmt.Errorf("model has no threshold; use -thresh")
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := gibberdet.WritePythonJSON(&buf, m, thresh); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(args[1], buf.Bytes(), 0644)
+}
+
+// matrixComma chooses CSV or TSV for 'export' and 'import' by extension.
+func matrixComma(file string) rune {
+	if strings.HasSuffix(file, ".tsv") {
+		return '\t'
+	}
+	return ','
+}
+
+func writeMatrix(file string, a gibberdet.Alphabet, rows [][]float64) error {
+	var buf bytes.Buffer
+	if err := gibberdet.WriteMatrix(&buf, a, rows, matrixComma(file)); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(file, buf.Bytes(), 0644)
+}
+
+func export(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("usage: tool.go export <model> <out.csv|out.tsv>\n" +
+			"writes the log probabilities; use 'tool.go train -counts' for the raw counts")
+	}
+
+	m, err := gibberdet.Load(args[0])
+	if err != nil {
+		return err
+	}
+	return writeMatrix(args[1], m.Alphabet(), m.Matrix())
+}
+
+func importMatrix(args []string) error {
+	var counts, normalize bool
+	var pairWeight float64
+	var storage string
+	fs := flag.NewFlagSet("", 0)
+	fs.BoolVar(&counts, "counts", false, "The matrix holds raw counts, as written by 'tool.go train -counts'")
+	fs.Float64Var(&pairWeight, "pairweight", 0, ""+
+		"Add this to every count with -counts. Counts written by 'tool.go train' already include the trainer's pair weight, "+
+		"but a count set to 0 needs this to be more than 0")
+	fs.BoolVar(&normalize, "normalize", false, "Normalize the log probabilities in each row so that they sum to 1")
+	fs.StringVar(&storage, "storage", "auto", ""+
+		"Transition storage. Accepts 'auto', 'dense', 'sparse', 'float32', 'quant16' or 'quant8'")
+	fs.Parse(args)
+
+	args = fs.Args()
+	if len(args) != 2 {
+		return fmt.Errorf("usage: tool.go import [-counts [-pairweight=<weight>]] [-normalize] [-storage=<storage>] <in.csv|in.tsv> <out>")
+	}
+
+	st, err := gibberdet.ParseStorage(storage)
+	if err != nil {
+		return err
 	}
 
-	f, err := os.Open(inFile)
+	f, err := os.Open(args[0])
 	if err != nil {
 		return err
 	}
 	defer f.Close()
 
-	m, err := gibberdet.Train(a, f)
+	a, rows, err := gibberdet.ReadMatrix(f, matrixComma(args[0]))
 	if err != nil {
 		return err
 	}
 
-	enc, err := m.MarshalBinary()
+	if counts {
+		if rows, err = gibberdet.MatrixFromCounts(rows, pairWeight); err != nil {
+			return err
+		}
+	} else if normalize {
+		for _, row := range rows {
+			var sum float64
+			for _, v := range row {
+				sum += math.Exp(v)
+			}
+			for j := range row {
+				row[j] -= math.Log(sum)
+			}
+		}
+	}
+
+	m, err := gibberdet.NewModelFromMatrix(a, rows)
 	if err != nil {
 		return err
 	}
+	if m, err = m.Convert(st); err != nil {
+		return err
+	}
+	return m.Save(args[1])
+}
+
+func info(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: tool.go info <model>")
+	}
 
-	if err := ioutil.WriteFile(outFile, enc, 0644); err != nil {
+	m, err := gibberdet.Load(args[0])
+	if err != nil {
 		return err
 	}
 
+	fmt.Printf("alphabet: %d runes, %s\n", m.Alphabet().Len(), gibberdet.AlphabetKind(m.Alphabet()))
+	fmt.Printf("storage:  %s\n", m.Storage())
+
+	meta := m.Metadata()
+	keys := make([]string, 0, len(meta))
+	for k := range meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Printf("%s: %s\n", k, meta[k])
+	}
+	return nil
+}
+
+func diff(args []string) error {
+	var samplesFile string
+	var rows int
+
+	fs := flag.NewFlagSet("", 0)
+	fs.StringVar(&samplesFile, "samples", "", "File containing one sample per line to score with both models")
+	fs.IntVar(&rows, "rows", 10, "Number of most divergent rows to show")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	args = fs.Args()
+	if len(args) != 2 {
+		return fmt.Errorf("usage: tool.go diff [-samples=<file>] [-rows=<n>] <modelA> <modelB>")
+	}
+
+	var models [2]*gibberdet.Model
+	for i, file := range args {
+		m, err := gibberdet.Load(file)
+		if err != nil {
+			return err
+		}
+		models[i] = m
+	}
+
+	var samples []string
+	if samplesFile != "" {
+		var err error
+		if samples, err = readStringList(samplesFile); err != nil {
+			return err
+		}
+	}
+
+	cmp := gibberdet.CompareModels(models[0], models[1], samples...)
+
+	buf := bufio.NewWriter(os.Stdout)
+	defer buf.Flush()
+
+	fmt.Fprintf(buf, "shared runes: %d\n", len(cmp.Shared))
+	fmt.Fprintf(buf, "only in A:    %q\n", string(cmp.OnlyA))
+	fmt.Fprintf(buf, "only in B:    %q\n", string(cmp.OnlyB))
+	fmt.Fprintf(buf, "mean JS:      %0.6f\n", cmp.MeanJS)
+
+	sorted := append([]gibberdet.RowDivergence(nil), cmp.Rows...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].JS > sorted[j].JS })
+	if len(sorted) > rows {
+		sorted = sorted[:rows]
+	}
+	fmt.Fprintln(buf, "\nmost divergent rows:")
+	for _, row := range sorted {
+		fmt.Fprintf(buf, "%q\tJS=%0.6f\tKL=%0.6f\n", row.Rune, row.JS, row.KL)
+	}
+
+	fmt.Fprintln(buf, "\nlargest transition changes:")
+	for _, c := range cmp.TopChanges {
+		fmt.Fprintf(buf, "%q -> %q\t%0.6f\t%0.6f\t%+0.6f\n", c.From, c.To, c.ProbA, c.ProbB, c.ProbB-c.ProbA)
+	}
+
+	if len(cmp.Scores) > 0 {
+		fmt.Fprintln(buf, "\nscores:")
+		for _, s := range cmp.Scores {
+			fmt.Fprintf(buf, "%0.8f\t%0.8f\t%+0.8f\t%s\n", s.A, s.B, s.B-s.A, s.Input)
+		}
+	}
+
 	return nil
 }
 
@@ -121,16 +611,11 @@ func test(args []string) error {
 		return fmt.Errorf("usage: tool.go test <model> <goodfile> <badfile>")
 	}
 
-	bts, err := ioutil.ReadFile(args[0])
+	m, err := gibberdet.Load(args[0])
 	if err != nil {
 		return err
 	}
 
-	var m gibberdet.Model
-	if err := m.UnmarshalBinary(bts); err != nil {
-		return err
-	}
-
 	good, err := readStringList(args[1])
 	if err != nil {
 		return err
@@ -153,9 +638,11 @@ func test(args []string) error {
 
 func gibfile(args []string) error {
 	var minSize int
+	var encoding string
 
 	fs := flag.NewFlagSet("", 0)
 	fs.IntVar(&minSize, "minsz", 5, "skip terms shorter than this many runes")
+	fs.StringVar(&encoding, "encoding", "utf-8", "encoding of the file")
 	if err := fs.Parse(args); err != nil {
 		return err
 	}
@@ -165,7 +652,7 @@ func gibfile(args []string) error {
 		return fmt.Errorf("usage: tool.go gibfile <model> <file>")
 	}
 
-	bts, err := ioutil.ReadFile(args[0])
+	m, err := gibberdet.Load(args[0])
 	if err != nil {
 		return err
 	}
@@ -175,8 +662,12 @@ func gibfile(args []string) error {
 		return err
 	}
 
-	var m gibberdet.Model
-	if err := m.UnmarshalBinary(bts); err != nil {
+	enc, err := gibberdet.ParseEncoding(encoding)
+	if err != nil {
+		return err
+	}
+	em, err := m.WithEncoding(enc)
+	if err != nil {
 		return err
 	}
 
@@ -185,7 +676,7 @@ func gibfile(args []string) error {
 		if utf8.RuneCountInString(s) < minSize {
 			continue
 		}
-		v := m.GibberScore(s)
+		v := em.GibberScore(s)
 		fmt.Fprintf(buf, "%0.8f\t%s\n", v, s)
 	}
 	buf.Flush()
@@ -198,16 +689,11 @@ func gib(args []string) error {
 		return fmt.Errorf("usage: tool.go gib <model> <teststr>")
 	}
 
-	bts, err := ioutil.ReadFile(args[0])
+	m, err := gibberdet.Load(args[0])
 	if err != nil {
 		return err
 	}
 
-	var m gibberdet.Model
-	if err := m.UnmarshalBinary(bts); err != nil {
-		return err
-	}
-
 	fmt.Println("str:", m.GibberScore(args[1]))
 	fmt.Println("bts:", m.GibberScoreBytes([]byte(args[1])))
 
@@ -226,12 +712,10 @@ func oanc(args []string) error {
 		inFile = args[1]
 	}
 
-	withUnderscores := true
-
 	if inFile == "" {
 		url := "http://www.anc.org/OANC/OANC_GrAF.zip"
 
-		tf, err := ioutil.TempFile("", "")
+		tf, err := ioutil.TempFile("", "*.zip")
 		if err != nil {
 			return err
 		}
@@ -257,63 +741,41 @@ func oanc(args []string) error {
 		inFile = tf.Name()
 	}
 
-	r, err := zip.OpenReader(inFile)
-	if err != nil {
-		return err
-	}
-	defer r.Close()
-
 	// Exclude numbers as a high incidence of numbers is usually indicative of gibberish
-	train := gibberdet.NewTrainer(gibberdet.ASCIIAlphaWordPunct, gibberdet.TrainerPairWeight(0))
-
-	for _, finf := range r.File {
-		if filepath.Ext(finf.Name) == ".txt" {
-			fmt.Println("adding", finf.Name)
-			rc, err := finf.Open()
-			if err != nil {
-				return err
-			}
-			bts, err := ioutil.ReadAll(rc)
-			if err != nil {
-				rc.Close()
-				return err
+	train := gibberdet.NewTrainer(gibberdet.ASCIIAlphaWordPunct,
+		gibberdet.TrainerPairWeight(0),
+		gibberdet.TrainerMetadata(gibberdet.MetaLanguage, "en", gibberdet.MetaCorpus, "oanc"),
+
+		// If you want the model not to penalise words_separated_by_underscores,
+		// this should help:
+		gibberdet.TrainerAugment(gibberdet.AugmentReplaceSpace('_', 1)))
+
+	// AddPath only opens inFile as a zip if it is named like one, so make
+	// sure something was found rather than compiling an empty model:
+	var added int
+	if err := train.AddPath(inFile,
+		gibberdet.CorpusInclude("*.txt"),
+		gibberdet.CorpusProgress(func(f gibberdet.CorpusFile) {
+			if !f.Skipped {
+				added++
 			}
-			rc.Close()
-
-			if err := train.Add(bytes.NewReader(bts)); err != nil {
-				return err
-			}
-
-			// If you want the model not to penalise words_separated_by_underscores,
-			// this should help:
-			if withUnderscores {
-				bts = spaceReplacePattern.ReplaceAll(bts, []byte("_"))
-				if err := train.Add(bytes.NewReader(bts)); err != nil {
-					return err
-				}
-			}
-		}
-	}
-
-	model, err := train.Compile()
-	if err != nil {
+			printCorpusProgress(f)
+		}),
+	); err != nil {
 		return err
 	}
-
-	data, err := model.MarshalBinary()
-	if err != nil {
-		return err
+	if added == 0 {
+		return fmt.Errorf("no .txt files found in %s; expected the OANC zip (named *.zip) or a directory", inFile)
 	}
 
-	if err := ioutil.WriteFile(args[0], data, 0600); err != nil {
+	model, err := train.Compile()
+	if err != nil {
 		return err
 	}
 
-	return nil
+	return model.Save(args[0])
 }
 
-var spaceReplacePattern = regexp.MustCompile(`\s+`)
-
 func readStringList(fname string) (out []string, err error) {
 	bts, err := ioutil.ReadFile(fname)
 	if err != nil {
@@ -326,6 +788,23 @@ func readStringList(fname string) (out []string, err error) {
 	return out, nil
 }
 
+func printCorpusProgress(f gibberdet.CorpusFile) {
+	if f.Skipped {
+		fmt.Fprintf(os.Stderr, "skipped %s (%s)\n", f.Path, f.SkipReason)
+	} else {
+		fmt.Fprintf(os.Stderr, "added %s (%d bytes)\n", f.Path, f.Bytes)
+	}
+}
+
+type stringList []string
+
+func (s *stringList) String() string { return strings.Join(*s, ",") }
+
+func (s *stringList) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
 type filterAsciiReader struct {
 	rdr io.Reader
 }
@@ -15,10 +15,18 @@ const DefaultPairWeight = 10
 
 type Trainer struct {
 	alpha      Alphabet
+	other      int // Position of OtherRune, or -1
 	ascii      *asciiAlphabet
+	storage    Storage
+	encoding   Encoding
+	compat     CompatMode
 	gram       []float64
+	counts     map[int]float64 // Used instead of gram for StorageSparse
 	scratch    []byte
 	pairWeight float64
+	augments   []Augmentation
+	meta       map[string]string
+	stats      trainerStats
 }
 
 type TrainerOption func(t *Trainer)
@@ -29,93 +37,196 @@ func TrainerPairWeight(w float64) TrainerOption {
 	}
 }
 
+// TrainerAugment adds Augmentations that are applied to every input passed
+// to Trainer.Add.
+func TrainerAugment(augs ...Augmentation) TrainerOption {
+	return func(t *Trainer) {
+		t.augments = append(t.augments, augs...)
+	}
+}
+
+// TrainerStorage selects how the compiled Model stores its transitions. The
+// default is StorageAuto.
+func TrainerStorage(s Storage) TrainerOption {
+	return func(t *Trainer) {
+		t.storage = s
+	}
+}
+
+// TrainerEncoding sets the encoding of the input passed to Add. The default
+// is EncodingUTF8. It has no effect with ByteAlphabet, which never decodes its
+// input.
+func TrainerEncoding(e Encoding) TrainerOption {
+	return func(t *Trainer) {
+		t.encoding = e
+	}
+}
+
 func NewTrainer(alpha Alphabet, opts ...TrainerOption) *Trainer {
 	scratch := make([]byte, 8192)
 
 	t := &Trainer{
 		alpha:      alpha,
-		gram:       make([]float64, alpha.Len()*alpha.Len()),
+		other:      alpha.FindRune(OtherRune),
 		scratch:    scratch,
 		pairWeight: DefaultPairWeight,
+		stats: trainerStats{
+			rows:    make([]int64, alpha.Len()),
+			skipped: make(map[rune]int64),
+		},
 	}
 
 	for _, o := range opts {
 		o(t)
 	}
 
-	for i := range t.gram {
-		t.gram[i] = t.pairWeight
+	t.storage = t.storage.resolve(alpha.Len())
+	if t.storage == StorageSparse {
+		// The pair weight is applied when the model is compiled:
+		t.counts = make(map[int]float64)
+	} else {
+		t.gram = make([]float64, alpha.Len()*alpha.Len())
+		for i := range t.gram {
+			t.gram[i] = t.pairWeight
+		}
 	}
 
 	return t
 }
 
+// trainSeq tracks a single stream of transitions through Trainer.Add; one for
+// the input itself, and one for each Augmentation.
+type trainSeq struct {
+	aug    *Augmentation
+	weight float64
+	last   int
+	first  bool
+	prev   rune
+}
+
 func (t *Trainer) Add(rdr io.Reader) error {
-	var pos int
-	var leftover []byte
-	var first = true
-	var last int
+	seqs := make([]trainSeq, 1+len(t.augments))
+	seqs[0] = trainSeq{weight: 1, first: true}
+	for i := range t.augments {
+		seqs[i+1] = trainSeq{aug: &t.augments[i], weight: t.augments[i].Weight, first: true}
+	}
 
-	alphaLen := t.alpha.Len()
+	charset, err := t.encoding.charset()
+	if err != nil {
+		return err
+	}
+	if _, ok := t.alpha.(*byteAlphabet); ok {
+		// Each byte is the rune with the same value:
+		charset = &charsetLatin1
+	}
 
-	for {
-	read:
-		if len(leftover) > 0 {
-			pos = copy(t.scratch, leftover)
-		} else {
-			pos = 0
+	fn := func(r rune, sz int) {
+		t.stats.bytes += int64(sz)
+		if r == utf8.RuneError && sz == 1 {
+			t.stats.invalid++
+			return
+		}
+		t.stats.runes++
+
+		for i := range seqs {
+			t.observe(&seqs[i], r)
 		}
+	}
+	if charset != nil {
+		return scanCharset(rdr, t.scratch, charset, fn)
+	}
+	return scanRunes(rdr, t.scratch, fn)
+}
 
-		n, err := rdr.Read(t.scratch[pos:])
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return err
+func (t *Trainer) observe(seq *trainSeq, r rune) {
+	in := r
+	if seq.aug != nil {
+		var ok bool
+		r, ok = seq.aug.Map(seq.prev, in)
+		seq.prev = in
+		if !ok {
+			return
 		}
+	}
+	if t.compat == CompatPython {
+		r = compatLower(r)
+	}
 
-		end := n + pos
-
-		for pos < end {
-			r, sz := utf8.DecodeRune(t.scratch[pos:])
-			if r == utf8.RuneError {
-				if end-pos <= 4 {
-					pos += sz
-					leftover = t.scratch[pos:end]
-					goto read
-				} else {
-					pos += sz
-					continue
-				}
+	alphaIdx := t.alpha.FindRune(r)
+	if alphaIdx < 0 {
+		alphaIdx = t.other
+	}
+	if alphaIdx >= 0 {
+		if !seq.first {
+			if t.gram != nil {
+				t.gram[seq.last*t.alpha.Len()+alphaIdx] += seq.weight
+			} else {
+				t.counts[seq.last*t.alpha.Len()+alphaIdx] += seq.weight
 			}
-
-			alphaIdx := t.alpha.FindRune(r)
-			pos += sz
-			if alphaIdx >= 0 {
-				if !first {
-					t.gram[last*alphaLen+alphaIdx]++
-				} else {
-					first = false
-				}
-				last = alphaIdx
-
-			} else if !first {
-				first = true
+			t.stats.rows[seq.last]++
+		} else {
+			seq.first = false
+			if seq.aug == nil {
+				t.stats.sequences++
 			}
 		}
+		seq.last = alphaIdx
+
+	} else {
+		if seq.aug == nil {
+			t.stats.skipped[r]++
+			t.stats.skippedTotal++
+		}
+		if !seq.first && t.compat == CompatNone {
+			seq.first = true
+		}
 	}
 
-	return nil
+	// The Python code trains on each line separately:
+	if t.compat == CompatPython && r == '\n' {
+		seq.first = true
+	}
 }
 
-func (t *Trainer) Compile() (*Model, error) {
+func (t *Trainer) Compile() (m *Model, err error) {
 	alphaLen := t.alpha.Len()
+	if err := t.compat.check(t.alpha); err != nil {
+		return nil, err
+	}
+
+	// The model decodes its input the same way as the trainer:
+	charset, err := t.encoding.charset()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.storage == StorageSparse {
+		table, err := newSparseTable(alphaLen, t.counts, t.pairWeight)
+		if err != nil {
+			return nil, err
+		}
+		m = &Model{
+			alpha:    t.alpha,
+			table:    table,
+			encoding: t.encoding,
+			charset:  charset,
+			compat:   t.compat,
+			meta:     t.metadata(),
+		}
+		m.init()
+		return m, nil
+	}
 
 	gram := make([]float64, len(t.gram))
 	copy(gram, t.gram)
 
-	m := &Model{
-		alpha: t.alpha,
-		gram:  gram,
+	m = &Model{
+		alpha:    t.alpha,
+		gram:     gram,
+		encoding: t.encoding,
+		charset:  charset,
+		compat:   t.compat,
+		meta:     t.metadata(),
 	}
 	m.init()
 
@@ -144,5 +255,12 @@ func (t *Trainer) Compile() (*Model, error) {
 		}
 	}
 
+	if t.storage != StorageDense {
+		if m.table, err = newTable(gram, alphaLen, t.storage); err != nil {
+			return nil, err
+		}
+		m.init()
+	}
+
 	return m, nil
 }
@@ -0,0 +1,62 @@
+package gibberdet
+
+import (
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestTrainerAugment(t *testing.T) {
+	a := NewAlphabet([]rune("abcdABCD_- "))
+
+	for idx, tc := range []struct {
+		aug   Augmentation
+		in    string
+		pairs []string
+	}{
+		{AugmentReplaceSpace('_', 2), "ab  cd", []string{"b_", "_c"}},
+		{AugmentReplaceSpace('-', 2), "ab cd", []string{"b-", "-c"}},
+		{AugmentCamelCase(2), "ab cd", []string{"bC"}},
+		{AugmentUpper(2), "ab cd", []string{"AB", "B ", " C", "CD"}},
+		{AugmentTitle(2), "ab CD", []string{"Ab", "Cd"}},
+	} {
+		tr := NewTrainer(a, TrainerPairWeight(0), TrainerAugment(tc.aug))
+		if err := tr.Add(strings.NewReader(tc.in)); err != nil {
+			t.Fatal(err)
+		}
+		for _, p := range tc.pairs {
+			rs := []rune(p)
+			v := tr.gram[a.FindRune(rs[0])*a.Len()+a.FindRune(rs[1])]
+			if v != 2 {
+				t.Fatal(idx, p, v)
+			}
+		}
+		if v := tr.gram[a.FindRune('_')*a.Len()+a.FindRune('_')]; v != 0 {
+			t.Fatal(idx, v)
+		}
+	}
+}
+
+func TestTrainerStats(t *testing.T) {
+	a := NewAlphabet([]rune("ab "))
+	tr := NewTrainer(a, TrainerAugment(AugmentUpper(1)))
+
+	// OneByteReader splits the multi-byte runes across reads:
+	if err := tr.Add(iotest.OneByteReader(strings.NewReader("aab\xffba 🙃🙃 x b"))); err != nil {
+		t.Fatal(err)
+	}
+
+	st := tr.Stats()
+	if st.Bytes != 19 || st.Runes != 12 || st.Invalid != 1 || st.Sequences != 3 || st.Skipped != 3 {
+		t.Fatalf("%+v", st)
+	}
+	if len(st.TopSkipped) != 2 || st.TopSkipped[0] != (RuneCount{Rune: '🙃', Count: 2}) {
+		t.Fatalf("%+v", st.TopSkipped)
+	}
+
+	// The upper-cased augmentation has no transitions that are in the alphabet:
+	rows := st.Unreliable(3)
+	if len(rows) != 2 || rows[0] != (RowStats{'b', 2}) || rows[1] != (RowStats{' ', 1}) {
+		t.Fatalf("%+v", rows)
+	}
+}